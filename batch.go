@@ -0,0 +1,158 @@
+package taglib
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ReadTagsBatch reads metadata tags for many files at once. Unlike repeated calls to
+// [ReadTags], files that share a parent directory reuse a single WASM module instance,
+// which amortizes the cost of scanning a large library.
+//
+// The returned map is keyed by the path as given in paths. If an individual file fails
+// to read, its error is joined into the returned error and it is omitted from the map;
+// remaining files are still processed.
+func ReadTagsBatch(paths []string) (map[string]map[string][]string, error) {
+	out := make(map[string]map[string][]string, len(paths))
+
+	var errs []error
+	err := withModulesByDir(paths, true, func(mod *module, orig, abs string) error {
+		var raw []string
+		if err := mod.call("taglib_file_tags", &raw, wasmPath(abs)); err != nil {
+			return fmt.Errorf("call: %w", err)
+		}
+		if raw == nil {
+			return ErrInvalidFile
+		}
+
+		tags := map[string][]string{}
+		for _, row := range raw {
+			k, v, ok := strings.Cut(row, "\t")
+			if !ok {
+				continue
+			}
+			tags[k] = append(tags[k], v)
+		}
+		out[orig] = tags
+		return nil
+	}, &errs)
+	if err != nil {
+		return out, err
+	}
+	return out, errors.Join(errs...)
+}
+
+// WriteTagsBatch writes tags to many files at once, reusing a single WASM module
+// instance per parent directory. tags is keyed by file path, and only the files it
+// names are written. See [ReadTagsBatch] for the error semantics.
+func WriteTagsBatch(tags map[string]map[string][]string, opts WriteOption) error {
+	paths := make([]string, 0, len(tags))
+	for p := range tags {
+		paths = append(paths, p)
+	}
+
+	var errs []error
+	err := withModulesByDir(paths, false, func(mod *module, orig, abs string) error {
+		var raw []string
+		for k, vs := range tags[orig] {
+			raw = append(raw, fmt.Sprintf("%s\t%s", k, strings.Join(vs, "\v")))
+		}
+
+		var out bool
+		if err := mod.call("taglib_file_write_tags", &out, wasmPath(abs), raw, uint8(opts)); err != nil {
+			return fmt.Errorf("call: %w", err)
+		}
+		if !out {
+			return ErrSavingFile
+		}
+		return nil
+	}, &errs)
+	if err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// ReadPropertiesBatch reads the audio properties for many files at once, reusing a
+// single WASM module instance per parent directory. See [ReadTagsBatch] for the error
+// and result semantics.
+func ReadPropertiesBatch(paths []string) (map[string]Properties, error) {
+	out := make(map[string]Properties, len(paths))
+
+	var errs []error
+	err := withModulesByDir(paths, true, func(mod *module, orig, abs string) error {
+		const (
+			audioPropertyLengthInMilliseconds = iota
+			audioPropertyChannels
+			audioPropertySampleRate
+			audioPropertyBitrate
+			audioPropertyLen
+		)
+
+		raw := make([]int, 0, audioPropertyLen)
+		if err := mod.call("taglib_file_audioproperties", &raw, wasmPath(abs)); err != nil {
+			return fmt.Errorf("call: %w", err)
+		}
+
+		out[orig] = Properties{
+			Length:     time.Duration(raw[audioPropertyLengthInMilliseconds]) * time.Millisecond,
+			Channels:   uint(raw[audioPropertyChannels]),
+			SampleRate: uint(raw[audioPropertySampleRate]),
+			Bitrate:    uint(raw[audioPropertyBitrate]),
+		}
+		return nil
+	}, &errs)
+	if err != nil {
+		return out, err
+	}
+	return out, errors.Join(errs...)
+}
+
+// batchPath pairs a path as given by the caller with its absolute form, so fn can use
+// the absolute path for the WASM call while the caller's original string is preserved
+// for keying results and looking back into caller-supplied maps.
+type batchPath struct {
+	orig, abs string
+}
+
+// withModulesByDir groups paths by parent directory and invokes fn once per path,
+// reusing one module per directory group, mounted read-only if readOnly is set. fn
+// receives both the path as
+// given by the caller and its absolute form. Per-path errors are appended to errs
+// rather than aborting the whole batch; errors setting up a directory's module abort
+// the batch and are returned directly.
+func withModulesByDir(paths []string, readOnly bool, fn func(mod *module, orig, abs string) error, errs *[]error) error {
+	groups := map[string][]batchPath{}
+	var order []string
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			*errs = append(*errs, fmt.Errorf("make path abs %q: %w", p, err))
+			continue
+		}
+
+		dir := filepath.Dir(abs)
+		if _, ok := groups[dir]; !ok {
+			order = append(order, dir)
+		}
+		groups[dir] = append(groups[dir], batchPath{orig: p, abs: abs})
+	}
+
+	for _, dir := range order {
+		mod, err := newModuleOpt(dir, readOnly)
+		if err != nil {
+			return fmt.Errorf("init module for %q: %w", dir, err)
+		}
+
+		for _, bp := range groups[dir] {
+			if err := fn(&mod, bp.orig, bp.abs); err != nil {
+				*errs = append(*errs, fmt.Errorf("%s: %w", bp.orig, err))
+			}
+		}
+		mod.close()
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package taglib
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// ReadTagsFromBuffer reads metadata tags from r, buffering it into memory and
+// sniffing its container format from the leading bytes. Unlike [ReadTags], the
+// source need not exist on the host filesystem. For sources that already know their
+// size and format, [ReadTagsFromReader] avoids the extra copy sniffing requires.
+func ReadTagsFromBuffer(r io.Reader) (map[string][]string, error) {
+	data, format, err := sniffReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return ReadTagsFromReader(bytes.NewReader(data), int64(len(data)), format)
+}
+
+// ReadPropertiesFromBuffer reads the audio properties from r, buffering it into
+// memory and sniffing its container format from the leading bytes.
+func ReadPropertiesFromBuffer(r io.Reader) (Properties, error) {
+	f, err := openSniffed(r, true)
+	if err != nil {
+		return Properties{}, err
+	}
+	defer f.Close()
+
+	return f.ReadProperties()
+}
+
+// ReadImageFromBuffer reads the first available embedded image from r, buffering it
+// into memory and sniffing its container format from the leading bytes.
+func ReadImageFromBuffer(r io.Reader) (io.Reader, error) {
+	f, err := openSniffed(r, true)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, err := f.ReadImageRaw()
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(img), nil
+}
+
+// WriteTagsToBuffer reads src, buffers it into memory, writes tags to it, and
+// returns the resulting file's bytes. The behavior of opts matches [WriteTags].
+func WriteTagsToBuffer(src io.Reader, tags map[string][]string, opts WriteOption) ([]byte, error) {
+	data, format, err := sniffReader(src)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, len(data), len(data)+4096)
+	copy(buf, data)
+
+	rws := &growableBuffer{buf: buf}
+	if err := WriteTagsToReadWriteSeeker(rws, int64(len(buf)), tags, format, opts); err != nil {
+		return nil, err
+	}
+	return rws.buf, nil
+}
+
+// openSniffed buffers r into memory, sniffs its container format, and opens it the
+// same way [OpenReader] does: as a synthetic in-memory guest file rather than a host
+// temporary one, unless it's at or above [SetReaderMemoryThreshold].
+func openSniffed(r io.Reader, readonly bool) (*File, error) {
+	data, format, err := sniffReader(r)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := format.ext()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := openReaderDataExt(data, ext, readonly, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open stream: %w", err)
+	}
+	return f, nil
+}
+
+// sniffReader buffers r fully into memory and identifies its container format from
+// magic bytes in its header.
+func sniffReader(r io.Reader) ([]byte, Format, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, fmt.Errorf("read buffer: %w", err)
+	}
+
+	format, err := sniffFormat(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, format, nil
+}
+
+// sniffFormat identifies a container format from the magic bytes at the start of
+// data.
+func sniffFormat(data []byte) (Format, error) {
+	switch {
+	case hasPrefix(data, "fLaC"):
+		return FormatFLAC, nil
+	case hasPrefix(data, "ID3") || hasPrefix(data, "\xff\xfb") || hasPrefix(data, "\xff\xf3"):
+		return FormatMP3, nil
+	case hasPrefix(data, "RIFF") && len(data) >= 12 && string(data[8:12]) == "WAVE":
+		return FormatWAV, nil
+	case hasPrefix(data, "FORM") && len(data) >= 12 && string(data[8:12]) == "AIFF":
+		return FormatAIFF, nil
+	case len(data) >= 12 && string(data[4:8]) == "ftyp":
+		return FormatMP4, nil
+	case hasPrefix(data, "OggS"):
+		return sniffOgg(data), nil
+	case hasPrefix(data, "MAC "):
+		return FormatAPE, nil
+	case hasPrefix(data, "\x30\x26\xb2\x75"): // ASF/WMA GUID header
+		return FormatWMA, nil
+	default:
+		return 0, fmt.Errorf("unrecognized stream format")
+	}
+}
+
+// sniffOgg distinguishes the Ogg-contained codecs this package knows about by the
+// identification header that follows the first page's header.
+func sniffOgg(data []byte) Format {
+	switch {
+	case bytes.Contains(data[:min(len(data), 64)], []byte("FLAC")):
+		return FormatOGGFLAC
+	case bytes.Contains(data[:min(len(data), 64)], []byte("Opus")):
+		return FormatOGGOpus
+	default:
+		return FormatOGG
+	}
+}
+
+func hasPrefix(data []byte, prefix string) bool {
+	return len(data) >= len(prefix) && string(data[:len(prefix)]) == prefix
+}
+
+// growableBuffer is a minimal in-memory io.ReadWriteSeeker backing
+// [WriteTagsToBuffer], since TagLib's write path may grow or shrink a file.
+type growableBuffer struct {
+	buf []byte
+	pos int
+}
+
+func (b *growableBuffer) Seek(offset int64, whence int) (int64, error) {
+	var base int
+	switch whence {
+	case io.SeekStart:
+		base = 0
+	case io.SeekCurrent:
+		base = b.pos
+	case io.SeekEnd:
+		base = len(b.buf)
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	pos := base + int(offset)
+	if pos < 0 {
+		return 0, fmt.Errorf("negative seek position")
+	}
+	b.pos = pos
+	return int64(pos), nil
+}
+
+func (b *growableBuffer) Read(p []byte) (int, error) {
+	if b.pos >= len(b.buf) {
+		return 0, io.EOF
+	}
+	n := copy(p, b.buf[b.pos:])
+	b.pos += n
+	return n, nil
+}
+
+func (b *growableBuffer) Write(p []byte) (int, error) {
+	end := b.pos + len(p)
+	if end > len(b.buf) {
+		grown := make([]byte, end)
+		copy(grown, b.buf)
+		b.buf = grown
+	}
+	n := copy(b.buf[b.pos:end], p)
+	b.pos = end
+	return n, nil
+}
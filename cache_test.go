@@ -0,0 +1,56 @@
+package taglib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveWASMCacheDir(t *testing.T) {
+	old := wasmCacheDir
+	defer func() { wasmCacheDir = old }()
+
+	t.Run("default", func(t *testing.T) {
+		wasmCacheDir = ""
+		t.Setenv("TAGLIB_WASM_CACHE_DIR", "")
+
+		if got := resolveWASMCacheDir(); got != wasmCacheDirDefault() {
+			t.Fatalf("got %q, want default %q", got, wasmCacheDirDefault())
+		}
+	})
+
+	t.Run("env var", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "cache")
+		wasmCacheDir = ""
+		t.Setenv("TAGLIB_WASM_CACHE_DIR", dir)
+
+		if got := resolveWASMCacheDir(); got != dir {
+			t.Fatalf("got %q, want %q", got, dir)
+		}
+		if _, err := os.Stat(dir); err != nil {
+			t.Fatalf("expected cache dir to be created: %v", err)
+		}
+	})
+
+	t.Run("explicit takes precedence over env var", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "explicit")
+		SetCompilationCacheDir(dir)
+		t.Setenv("TAGLIB_WASM_CACHE_DIR", filepath.Join(t.TempDir(), "other"))
+
+		if got := resolveWASMCacheDir(); got != dir {
+			t.Fatalf("got %q, want %q", got, dir)
+		}
+	})
+
+	t.Run("falls back when configured dir can't be created", func(t *testing.T) {
+		parent := filepath.Join(t.TempDir(), "not-a-dir")
+		if err := os.WriteFile(parent, []byte("x"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		SetCompilationCacheDir(filepath.Join(parent, "cache"))
+
+		if got := resolveWASMCacheDir(); got != wasmCacheDirDefault() {
+			t.Fatalf("got %q, want fallback to default %q", got, wasmCacheDirDefault())
+		}
+	})
+}
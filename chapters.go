@@ -0,0 +1,103 @@
+package taglib
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chapter is a single named section of a file, as used by ID3v2 CHAP/CTOC, MP4
+// chpl, and Matroska chapter atoms.
+type Chapter struct {
+	Title string
+	Start time.Duration
+	// End is the chapter's end offset, or 0 if the format doesn't record one.
+	End time.Duration
+}
+
+// ReadChapters reads the chapter list from the file at path, in playback order. It
+// returns an empty, non-nil slice if the file has no chapters.
+func ReadChapters(path string) ([]Chapter, error) {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModuleRO(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	var rows []string
+	if err := mod.call("taglib_file_read_chapters", &rows, wasmPath(path)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	if rows == nil {
+		return nil, ErrInvalidFile
+	}
+
+	chapters := make([]Chapter, 0, len(rows))
+	for _, row := range rows {
+		c, err := parseChapterRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("parse chapter: %w", err)
+		}
+		chapters = append(chapters, c)
+	}
+	return chapters, nil
+}
+
+// WriteChapters replaces the chapter list in the file at path with chapters.
+func WriteChapters(path string, chapters []Chapter) error {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModule(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	rows := make([]string, 0, len(chapters))
+	for _, c := range chapters {
+		rows = append(rows, fmt.Sprintf("%s\t%d\t%d", c.Title, c.Start.Milliseconds(), c.End.Milliseconds()))
+	}
+
+	var out bool
+	if err := mod.call("taglib_file_write_chapters", &out, wasmPath(path), rows); err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	if !out {
+		return ErrSavingFile
+	}
+	return nil
+}
+
+func parseChapterRow(row string) (Chapter, error) {
+	fields := strings.SplitN(row, "\t", 3)
+	if len(fields) != 3 {
+		return Chapter{}, fmt.Errorf("malformed chapter row %q", row)
+	}
+
+	start, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return Chapter{}, fmt.Errorf("parse start: %w", err)
+	}
+	end, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return Chapter{}, fmt.Errorf("parse end: %w", err)
+	}
+
+	return Chapter{
+		Title: fields[0],
+		Start: time.Duration(start) * time.Millisecond,
+		End:   time.Duration(end) * time.Millisecond,
+	}, nil
+}
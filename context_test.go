@@ -0,0 +1,52 @@
+package taglib_test
+
+import (
+	"context"
+	"testing"
+
+	"go.senan.xyz/taglib"
+)
+
+func TestReadWriteTagsContext(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+	ctx := context.Background()
+
+	err := taglib.WriteTagsContext(ctx, path, map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := taglib.ReadTagsContext(ctx, path)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+
+	_, err = taglib.ReadPropertiesContext(ctx, path)
+	nilErr(t, err)
+}
+
+func TestReadTagsContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := taglib.ReadTagsContext(ctx, path)
+	if err == nil {
+		t.Fatal("expected error from an already-canceled context")
+	}
+}
+
+func TestOpenContext(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	f, err := taglib.OpenContext(context.Background(), path)
+	nilErr(t, err)
+	defer f.Close()
+
+	err = f.WriteTags(map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+}
@@ -0,0 +1,80 @@
+package taglib_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"go.senan.xyz/taglib"
+)
+
+func TestOpenFile(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	f, err := taglib.Open(path)
+	nilErr(t, err)
+	defer f.Close()
+
+	err = f.WriteTags(map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := f.ReadTags()
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
+
+func TestOpenFileReadonly(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	f, err := taglib.OpenReadonly(path)
+	nilErr(t, err)
+	defer f.Close()
+
+	_, err = f.ReadTags()
+	nilErr(t, err)
+
+	err = f.WriteTags(map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	eq(t, err, taglib.ErrReadonly)
+}
+
+func TestOpenReader(t *testing.T) {
+	t.Parallel()
+
+	f, err := taglib.OpenReader(bytes.NewReader(egFLAC))
+	nilErr(t, err)
+	defer f.Close()
+
+	_, err = f.ReadTags()
+	nilErr(t, err)
+
+	err = f.WriteTags(map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	eq(t, err, taglib.ErrReadonly)
+}
+
+func TestOpenReaderAt(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+	rw, err := os.OpenFile(path, os.O_RDWR, 0o600)
+	nilErr(t, err)
+	defer rw.Close()
+
+	info, err := rw.Stat()
+	nilErr(t, err)
+
+	f, err := taglib.OpenReaderAt(rw, info.Size())
+	nilErr(t, err)
+
+	err = f.WriteTags(map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	nilErr(t, f.Close())
+
+	got, err := taglib.ReadTags(path)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
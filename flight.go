@@ -0,0 +1,48 @@
+package taglib
+
+import "sync"
+
+// flightCall is the in-flight or completed result shared by callers that coalesced on
+// the same key.
+type flightCall[T any] struct {
+	wg  sync.WaitGroup
+	val T
+	err error
+}
+
+// flightGroup coalesces concurrent calls that share a key into a single execution of
+// fn, so that a burst of e.g. [ReadTags] calls against the same path only instantiates
+// one WASM module and every caller shares its result.
+type flightGroup[T any] struct {
+	mu sync.Mutex
+	m  map[string]*flightCall[T]
+}
+
+// do executes fn for key, or waits for and shares the result of an identical call
+// already in flight. The returned bool is true if this caller waited for and received
+// the result of a call another caller started; the caller that ran fn gets false.
+func (g *flightGroup[T]) do(key string, fn func() (T, error)) (T, error, bool) {
+	g.mu.Lock()
+	if g.m == nil {
+		g.m = map[string]*flightCall[T]{}
+	}
+	if c, ok := g.m[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return c.val, c.err, true
+	}
+
+	c := &flightCall[T]{}
+	c.wg.Add(1)
+	g.m[key] = c
+	g.mu.Unlock()
+
+	c.val, c.err = fn()
+	c.wg.Done()
+
+	g.mu.Lock()
+	delete(g.m, key)
+	g.mu.Unlock()
+
+	return c.val, c.err, false
+}
@@ -0,0 +1,80 @@
+package taglib
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFlightGroupCoalesces(t *testing.T) {
+	var g flightGroup[int]
+	var calls atomic.Int32
+
+	start := make(chan struct{})
+	release := make(chan struct{})
+
+	results := make([]int, 10)
+
+	var wg, arrived sync.WaitGroup
+	arrived.Add(len(results))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			arrived.Done()
+			v, err, _ := g.do("key", func() (int, error) {
+				calls.Add(1)
+				<-release
+				return 42, nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			results[i] = v
+		}(i)
+	}
+
+	close(start)
+	arrived.Wait() // every goroutine is past <-start before the in-flight call is let finish
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("got %d underlying calls, want 1", n)
+	}
+	for i, v := range results {
+		if v != 42 {
+			t.Fatalf("result[%d] = %d, want 42", i, v)
+		}
+	}
+}
+
+func TestFlightGroupDistinctKeys(t *testing.T) {
+	var g flightGroup[int]
+	var calls atomic.Int32
+
+	for _, key := range []string{"a", "b"} {
+		if _, _, shared := g.do(key, func() (int, error) {
+			calls.Add(1)
+			return 1, nil
+		}); shared {
+			t.Fatalf("call for key %q unexpectedly shared", key)
+		}
+	}
+
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("got %d underlying calls, want 2", n)
+	}
+}
+
+func TestFlightGroupClearsAfterCompletion(t *testing.T) {
+	var g flightGroup[int]
+
+	if _, _, shared := g.do("key", func() (int, error) { return 1, nil }); shared {
+		t.Fatal("first call unexpectedly shared")
+	}
+	if _, _, shared := g.do("key", func() (int, error) { return 2, nil }); shared {
+		t.Fatal("second call ran after the first completed, shouldn't share")
+	}
+}
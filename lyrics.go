@@ -0,0 +1,205 @@
+package taglib
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LyricLine is a single timestamped line of synchronized lyrics, as stored in an
+// ID3v2 SYLT frame or a Vorbis LYRICS comment.
+type LyricLine struct {
+	Time time.Duration
+	Text string
+}
+
+// EmbeddedLyrics holds one set of a file's embedded lyrics. A file may carry more
+// than one, e.g. the same lyrics in different languages.
+type EmbeddedLyrics struct {
+	// Language is an ISO 639-2 language code, or empty if the format doesn't record one.
+	Language string
+	// Description distinguishes multiple lyrics frames in the same file (ID3v2 USLT/SYLT).
+	Description string
+	// Unsynced is the plain, unsynchronized lyrics (ID3v2 USLT, Vorbis UNSYNCEDLYRICS, MP4 ©lyr).
+	Unsynced string
+	// Synced is the time-synchronized lyrics (ID3v2 SYLT, Vorbis LYRICS), if any.
+	Synced []LyricLine
+}
+
+// ReadLyrics reads every embedded lyrics entry from the file at path.
+func ReadLyrics(path string) ([]EmbeddedLyrics, error) {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModuleRO(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	var metaRows []string
+	if err := mod.call("taglib_file_read_lyrics_meta", &metaRows, wasmPath(path)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	if metaRows == nil {
+		return nil, ErrInvalidFile
+	}
+
+	var lineRows []string
+	if err := mod.call("taglib_file_read_lyrics_lines", &lineRows, wasmPath(path)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+
+	entries := make([]EmbeddedLyrics, 0, len(metaRows))
+	for _, row := range metaRows {
+		e, err := parseLyricsMetaRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("parse lyrics entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+
+	for _, row := range lineRows {
+		idx, line, err := parseLyricsLineRow(row)
+		if err != nil {
+			return nil, fmt.Errorf("parse lyric line: %w", err)
+		}
+		if idx < 0 || idx >= len(entries) {
+			return nil, fmt.Errorf("lyric line references out-of-range entry %d", idx)
+		}
+		entries[idx].Synced = append(entries[idx].Synced, line)
+	}
+
+	return entries, nil
+}
+
+// WriteLyrics replaces every embedded lyrics entry in the file at path with entries.
+// The behavior of opts matches [WriteTags].
+func WriteLyrics(path string, entries []EmbeddedLyrics, opts WriteOption) error {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModule(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	metaRows := make([]string, 0, len(entries))
+	var lineRows []string
+	for i, e := range entries {
+		metaRows = append(metaRows, fmt.Sprintf("%s\t%s\t%s", e.Language, e.Description, e.Unsynced))
+		for _, l := range e.Synced {
+			lineRows = append(lineRows, fmt.Sprintf("%d\t%d\t%s", i, l.Time.Milliseconds(), l.Text))
+		}
+	}
+
+	var out bool
+	if err := mod.call("taglib_file_write_lyrics", &out, wasmPath(path), metaRows, lineRows, uint8(opts)); err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	if !out {
+		return ErrSavingFile
+	}
+	return nil
+}
+
+func parseLyricsMetaRow(row string) (EmbeddedLyrics, error) {
+	fields := strings.SplitN(row, "\t", 3)
+	if len(fields) != 3 {
+		return EmbeddedLyrics{}, fmt.Errorf("malformed lyrics entry row %q", row)
+	}
+	return EmbeddedLyrics{Language: fields[0], Description: fields[1], Unsynced: fields[2]}, nil
+}
+
+func parseLyricsLineRow(row string) (int, LyricLine, error) {
+	fields := strings.SplitN(row, "\t", 3)
+	if len(fields) != 3 {
+		return 0, LyricLine{}, fmt.Errorf("malformed lyric line row %q", row)
+	}
+
+	idx, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, LyricLine{}, fmt.Errorf("parse entry index: %w", err)
+	}
+	ms, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, LyricLine{}, fmt.Errorf("parse time: %w", err)
+	}
+
+	return idx, LyricLine{Time: time.Duration(ms) * time.Millisecond, Text: fields[2]}, nil
+}
+
+var lrcTimeTag = regexp.MustCompile(`\[(\d+):(\d+(?:\.\d+)?)\]`)
+
+// ParseLRC parses the LRC-format timed lyrics read from r, such as an .lrc sidecar
+// file. Lines with no timestamp tag are ignored; a line with several timestamp tags
+// (a common way to repeat a chorus) produces one [LyricLine] per tag. The
+// unsynchronized text of the result is the tagged lines' text, in order, newline
+// separated.
+func ParseLRC(r io.Reader) (EmbeddedLyrics, error) {
+	var lyrics EmbeddedLyrics
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		matches := lrcTimeTag.FindAllStringSubmatchIndex(line, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		text := strings.TrimSpace(line[matches[len(matches)-1][1]:])
+		for _, m := range matches {
+			minutes, err := strconv.Atoi(line[m[2]:m[3]])
+			if err != nil {
+				return EmbeddedLyrics{}, fmt.Errorf("parse minutes: %w", err)
+			}
+			seconds, err := strconv.ParseFloat(line[m[4]:m[5]], 64)
+			if err != nil {
+				return EmbeddedLyrics{}, fmt.Errorf("parse seconds: %w", err)
+			}
+
+			t := time.Duration(minutes)*time.Minute + time.Duration(seconds*float64(time.Second))
+			lyrics.Synced = append(lyrics.Synced, LyricLine{Time: t, Text: text})
+		}
+
+		if lyrics.Unsynced != "" {
+			lyrics.Unsynced += "\n"
+		}
+		lyrics.Unsynced += text
+	}
+	if err := scanner.Err(); err != nil {
+		return EmbeddedLyrics{}, fmt.Errorf("scan lrc: %w", err)
+	}
+
+	sort.Slice(lyrics.Synced, func(i, j int) bool { return lyrics.Synced[i].Time < lyrics.Synced[j].Time })
+	return lyrics, nil
+}
+
+// FormatLRC renders lyrics' synced lines as LRC-format text, one "[mm:ss.xx]text"
+// line per entry in time order.
+func FormatLRC(lyrics EmbeddedLyrics) string {
+	lines := append([]LyricLine(nil), lyrics.Synced...)
+	sort.Slice(lines, func(i, j int) bool { return lines[i].Time < lines[j].Time })
+
+	var sb strings.Builder
+	for _, l := range lines {
+		minutes := l.Time / time.Minute
+		seconds := float64(l.Time%time.Minute) / float64(time.Second)
+		fmt.Fprintf(&sb, "[%02d:%05.2f]%s\n", minutes, seconds, l.Text)
+	}
+	return sb.String()
+}
@@ -0,0 +1,99 @@
+// Package mbmap translates between the raw, format-specific tag keys that
+// [taglib.ReadTags] and [taglib.WriteTags] round-trip and the canonical MusicBrainz
+// Picard tag names, making the mapping TagLib applies internally via its PropertyMap
+// explicit and inspectable.
+package mbmap
+
+import "go.senan.xyz/taglib"
+
+// rawToCanonical tables are keyed by the exact raw tag key a format uses for a given
+// MusicBrainz field; canonicalToRaw is derived from them in init.
+var (
+	id3v2ToCanonical = map[string]string{
+		"TXXX:MusicBrainz Album Id":         taglib.MusicBrainzAlbumID,
+		"TXXX:MusicBrainz Artist Id":        taglib.MusicBrainzArtistID,
+		"TXXX:MusicBrainz Album Artist Id":  taglib.MusicBrainzAlbumArtistID,
+		"TXXX:MusicBrainz Release Group Id": taglib.MusicBrainzReleaseGroupID,
+		"TXXX:MusicBrainz Release Track Id": taglib.MusicBrainzReleaseTrackID,
+		"TXXX:MusicBrainz Work Id":          taglib.MusicBrainzWorkID,
+		"UFID:http://musicbrainz.org":       taglib.MusicBrainzTrackID,
+		"TXXX:Acoustid Id":                  taglib.AcoustIDID,
+		"TXXX:Acoustid Fingerprint":         taglib.AcoustIDFingerprint,
+	}
+
+	mp4ToCanonical = map[string]string{
+		"----:com.apple.iTunes:MusicBrainz Album Id":         taglib.MusicBrainzAlbumID,
+		"----:com.apple.iTunes:MusicBrainz Artist Id":        taglib.MusicBrainzArtistID,
+		"----:com.apple.iTunes:MusicBrainz Album Artist Id":  taglib.MusicBrainzAlbumArtistID,
+		"----:com.apple.iTunes:MusicBrainz Release Group Id": taglib.MusicBrainzReleaseGroupID,
+		"----:com.apple.iTunes:MusicBrainz Release Track Id": taglib.MusicBrainzReleaseTrackID,
+		"----:com.apple.iTunes:MusicBrainz Track Id":         taglib.MusicBrainzTrackID,
+		"----:com.apple.iTunes:MusicBrainz Work Id":          taglib.MusicBrainzWorkID,
+		"----:com.apple.iTunes:Acoustid Id":                  taglib.AcoustIDID,
+		"----:com.apple.iTunes:Acoustid Fingerprint":         taglib.AcoustIDFingerprint,
+	}
+
+	asfToCanonical = map[string]string{
+		"MusicBrainz/Album Id":            taglib.MusicBrainzAlbumID,
+		"MusicBrainz/Artist Id":           taglib.MusicBrainzArtistID,
+		"MusicBrainz/Album Artist Id":     taglib.MusicBrainzAlbumArtistID,
+		"MusicBrainz/Release Group Id":    taglib.MusicBrainzReleaseGroupID,
+		"WM/MusicBrainz Release Track Id": taglib.MusicBrainzReleaseTrackID,
+		"WM/MusicBrainz Track Id":         taglib.MusicBrainzTrackID,
+		"MusicBrainz/Work Id":             taglib.MusicBrainzWorkID,
+		"Acoustid/Id":                     taglib.AcoustIDID,
+		"Acoustid/Fingerprint":            taglib.AcoustIDFingerprint,
+	}
+
+	canonicalToRaw         = map[taglib.Format]map[string]string{}
+	rawToCanonicalByFormat = map[taglib.Format]map[string]string{
+		taglib.FormatMP3:  id3v2ToCanonical,
+		taglib.FormatWAV:  id3v2ToCanonical,
+		taglib.FormatAIFF: id3v2ToCanonical,
+		taglib.FormatMP4:  mp4ToCanonical,
+		taglib.FormatWMA:  asfToCanonical,
+	}
+)
+
+func init() {
+	for format, table := range rawToCanonicalByFormat {
+		reverse := make(map[string]string, len(table))
+		for raw, canonical := range table {
+			reverse[canonical] = raw
+		}
+		canonicalToRaw[format] = reverse
+	}
+}
+
+// Normalize translates raw, format-specific tag keys (as returned by
+// [taglib.ReadTags] for a file of the given format) to their canonical MusicBrainz
+// Picard names. Keys with no format-specific mapping (including Vorbis comments,
+// which already match the canonical names) are passed through unchanged.
+func Normalize(format taglib.Format, raw map[string][]string) map[string][]string {
+	table := rawToCanonicalByFormat[format]
+
+	out := make(map[string][]string, len(raw))
+	for k, v := range raw {
+		if canonical, ok := table[k]; ok {
+			k = canonical
+		}
+		out[k] = append(out[k], v...)
+	}
+	return out
+}
+
+// Denormalize translates canonical MusicBrainz Picard tag names to the raw key a
+// given format expects, ready to pass to [taglib.WriteTags]. Canonical keys with no
+// format-specific mapping are passed through unchanged.
+func Denormalize(format taglib.Format, canonical map[string][]string) map[string][]string {
+	table := canonicalToRaw[format]
+
+	out := make(map[string][]string, len(canonical))
+	for k, v := range canonical {
+		if raw, ok := table[k]; ok {
+			k = raw
+		}
+		out[k] = append(out[k], v...)
+	}
+	return out
+}
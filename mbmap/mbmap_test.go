@@ -0,0 +1,42 @@
+package mbmap_test
+
+import (
+	"testing"
+
+	"go.senan.xyz/taglib"
+	"go.senan.xyz/taglib/mbmap"
+)
+
+func TestNormalizeDenormalize(t *testing.T) {
+	raw := map[string][]string{
+		"----:com.apple.iTunes:MusicBrainz Album Id": {"c56a5905-2b3a-46f5-82c7-ce8eed01f876"},
+		"TITLE": {"Some Title"},
+	}
+
+	got := mbmap.Normalize(taglib.FormatMP4, raw)
+	want := map[string][]string{
+		taglib.MusicBrainzAlbumID: {"c56a5905-2b3a-46f5-82c7-ce8eed01f876"},
+		"TITLE":                   {"Some Title"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if len(got[k]) != len(v) || got[k][0] != v[0] {
+			t.Fatalf("got[%q] = %v, want %v", k, got[k], v)
+		}
+	}
+
+	back := mbmap.Denormalize(taglib.FormatMP4, got)
+	if _, ok := back["----:com.apple.iTunes:MusicBrainz Album Id"]; !ok {
+		t.Fatalf("denormalize didn't round-trip: %v", back)
+	}
+}
+
+func TestVorbisPassesThrough(t *testing.T) {
+	raw := map[string][]string{taglib.MusicBrainzAlbumID: {"id"}}
+	got := mbmap.Normalize(taglib.FormatOGG, raw)
+	if got[taglib.MusicBrainzAlbumID][0] != "id" {
+		t.Fatalf("expected passthrough, got %v", got)
+	}
+}
@@ -0,0 +1,175 @@
+package taglib
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metadata is a typed, format-agnostic view over the raw tag map returned by
+// [ReadTags]. Fields are populated from whichever format-specific spelling of a tag
+// a file actually uses, via the alias table described at [RegisterAlias].
+type Metadata struct {
+	Title       string
+	Album       string
+	Artist      string
+	Artists     []string
+	AlbumArtist []string
+	TrackNumber int
+	TrackTotal  int
+	DiscNumber  int
+	DiscTotal   int
+	Date        time.Time
+	Compilation bool
+	Comment     string
+	Lyrics      string
+
+	ReplayGainTrackGain float64
+	ReplayGainTrackPeak float64
+
+	MusicBrainzArtistID       string
+	MusicBrainzAlbumID        string
+	MusicBrainzAlbumArtistID  string
+	MusicBrainzTrackID        string
+	MusicBrainzReleaseGroupID string
+}
+
+// ReadMetadata reads the file at path with [ReadTags] and maps the result onto the
+// typed [Metadata] fields, resolving format-specific tag spellings via the alias
+// table (see [RegisterAlias]).
+func ReadMetadata(path string) (*Metadata, error) {
+	tags, err := ReadTags(path)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Metadata{
+		Title:       aliasFirst(tags, Title),
+		Album:       aliasFirst(tags, Album),
+		Artist:      aliasFirst(tags, Artist),
+		Artists:     aliasAll(tags, Artists),
+		AlbumArtist: aliasAll(tags, AlbumArtist),
+		Comment:     aliasFirst(tags, Comment),
+		Lyrics:      aliasFirst(tags, Lyrics),
+		Compilation: aliasBool(tags, Compilation),
+
+		MusicBrainzArtistID:       aliasFirst(tags, MusicBrainzArtistID),
+		MusicBrainzAlbumID:        aliasFirst(tags, MusicBrainzAlbumID),
+		MusicBrainzAlbumArtistID:  aliasFirst(tags, MusicBrainzAlbumArtistID),
+		MusicBrainzTrackID:        aliasFirst(tags, MusicBrainzTrackID),
+		MusicBrainzReleaseGroupID: aliasFirst(tags, MusicBrainzReleaseGroupID),
+
+		ReplayGainTrackGain: aliasFloat(tags, "REPLAYGAIN_TRACK_GAIN"),
+		ReplayGainTrackPeak: aliasFloat(tags, "REPLAYGAIN_TRACK_PEAK"),
+	}
+
+	m.TrackNumber, m.TrackTotal = aliasFraction(tags, TrackNumber, "TRACKTOTAL")
+	m.DiscNumber, m.DiscTotal = aliasFraction(tags, DiscNumber, "DISCTOTAL")
+	m.Date = aliasDate(tags, Date)
+
+	return m, nil
+}
+
+// aliases maps a canonical tag key (as used by [ReadTags]/[WriteTags]) to the extra,
+// format-specific spellings that should be treated as equivalent when resolving a
+// [Metadata] field. The canonical key itself is always checked first and need not be
+// listed here.
+var (
+	aliasesMu sync.RWMutex
+	aliases   = map[string][]string{
+		DiscNumber:  {"TPA", "DISC", "DISCNUMBER"},
+		Compilation: {"WM/IsCompilation", "TCMP", "COMPILATION"},
+		"TRACKTOTAL": {
+			"TOTALTRACKS", "TRACKTOTAL",
+			"----:com.apple.iTunes:TOTALTRACKS",
+		},
+		"DISCTOTAL": {
+			"TOTALDISCS", "DISCTOTAL",
+			"----:com.apple.iTunes:TOTALDISCS",
+		},
+		MusicBrainzArtistID:       {"----:com.apple.iTunes:MusicBrainz Artist Id"},
+		MusicBrainzAlbumID:        {"----:com.apple.iTunes:MusicBrainz Album Id"},
+		MusicBrainzAlbumArtistID:  {"----:com.apple.iTunes:MusicBrainz Album Artist Id"},
+		MusicBrainzTrackID:        {"----:com.apple.iTunes:MusicBrainz Track Id", "----:com.apple.iTunes:MusicBrainz Release Track Id"},
+		MusicBrainzReleaseGroupID: {"----:com.apple.iTunes:MusicBrainz Release Group Id"},
+	}
+)
+
+// RegisterAlias adds alternates as extra format-specific spellings of canonical, so
+// that [ReadMetadata] (and anything else consulting the alias table) resolves them
+// to the same field. Registering an alias for a canonical key that already has
+// aliases appends to, rather than replaces, the existing list.
+func RegisterAlias(canonical string, alternates ...string) {
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+	aliases[canonical] = append(aliases[canonical], alternates...)
+}
+
+// aliasKeys returns canonical followed by its registered aliases, which [aliasAll]
+// then looks up case-insensitively, since format-specific spellings (MP4 freeform
+// atom names, ASF attribute names) preserve whatever case the file or tagger that
+// wrote them used, not necessarily the case registered here.
+func aliasKeys(canonical string) []string {
+	aliasesMu.RLock()
+	defer aliasesMu.RUnlock()
+	return append([]string{canonical}, aliases[canonical]...)
+}
+
+func aliasAll(tags map[string][]string, canonical string) []string {
+	for _, k := range aliasKeys(canonical) {
+		if v, ok := tags[k]; ok {
+			return v
+		}
+		for tk, v := range tags {
+			if strings.EqualFold(tk, k) {
+				return v
+			}
+		}
+	}
+	return nil
+}
+
+func aliasFirst(tags map[string][]string, canonical string) string {
+	if v := aliasAll(tags, canonical); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func aliasBool(tags map[string][]string, canonical string) bool {
+	v := strings.TrimSpace(aliasFirst(tags, canonical))
+	return v == "1" || strings.EqualFold(v, "true")
+}
+
+func aliasFloat(tags map[string][]string, canonical string) float64 {
+	v := strings.TrimSpace(aliasFirst(tags, canonical))
+	v = strings.TrimSpace(strings.TrimSuffix(v, "dB"))
+	f, _ := strconv.ParseFloat(v, 64)
+	return f
+}
+
+// aliasFraction reads a canonical tag that may either be a single number, or an
+// ID3v2-style "n/m" tuple, falling back to a separate total tag (e.g. TRACKTOTAL) for
+// formats that keep the total as its own key.
+func aliasFraction(tags map[string][]string, canonical, totalCanonical string) (n, total int) {
+	v := aliasFirst(tags, canonical)
+	if num, rest, ok := strings.Cut(v, "/"); ok {
+		n, _ = strconv.Atoi(strings.TrimSpace(num))
+		total, _ = strconv.Atoi(strings.TrimSpace(rest))
+		return n, total
+	}
+	n, _ = strconv.Atoi(strings.TrimSpace(v))
+	total, _ = strconv.Atoi(strings.TrimSpace(aliasFirst(tags, totalCanonical)))
+	return n, total
+}
+
+func aliasDate(tags map[string][]string, canonical string) time.Time {
+	v := strings.TrimSpace(aliasFirst(tags, canonical))
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
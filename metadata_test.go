@@ -0,0 +1,57 @@
+package taglib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAliasFraction(t *testing.T) {
+	n, total := aliasFraction(map[string][]string{"TRACKNUMBER": {"3/12"}}, "TRACKNUMBER", "TRACKTOTAL")
+	eq(t, n, 3)
+	eq(t, total, 12)
+
+	n, total = aliasFraction(map[string][]string{
+		"TRACKNUMBER": {"3"},
+		"TRACKTOTAL":  {"12"},
+	}, "TRACKNUMBER", "TRACKTOTAL")
+	eq(t, n, 3)
+	eq(t, total, 12)
+}
+
+func TestAliasCompilation(t *testing.T) {
+	got := aliasBool(map[string][]string{"TCMP": {"1"}}, Compilation)
+	eq(t, got, true)
+}
+
+func TestAliasCompilationWMACase(t *testing.T) {
+	// ASF attribute names are case-preserved by TagLib, not upper-cased like ID3
+	// frame IDs, so the alias is registered in that same mixed-case spelling.
+	got := aliasBool(map[string][]string{"WM/IsCompilation": {"1"}}, Compilation)
+	eq(t, got, true)
+}
+
+func TestAliasMusicBrainzFreeformCase(t *testing.T) {
+	// MP4 freeform atom names are case-preserved ("----:com.apple.iTunes:...").
+	got := aliasFirst(map[string][]string{
+		"----:com.apple.iTunes:MusicBrainz Album Id": {"c56a5905-2b3a-46f5-82c7-ce8eed01f876"},
+	}, MusicBrainzAlbumID)
+	eq(t, got, "c56a5905-2b3a-46f5-82c7-ce8eed01f876")
+}
+
+func TestAliasDate(t *testing.T) {
+	got := aliasDate(map[string][]string{Date: {"1993-04-02"}}, Date)
+	eq(t, got, time.Date(1993, 4, 2, 0, 0, 0, 0, time.UTC))
+}
+
+func TestRegisterAlias(t *testing.T) {
+	RegisterAlias("CUSTOMFIELD", "X_CUSTOM_FIELD")
+	got := aliasFirst(map[string][]string{"X_CUSTOM_FIELD": {"hello"}}, "CUSTOMFIELD")
+	eq(t, got, "hello")
+}
+
+func eq[T comparable](t testing.TB, a, b T) {
+	if a != b {
+		t.Helper()
+		t.Fatalf("%v != %v", a, b)
+	}
+}
@@ -0,0 +1,178 @@
+package taglib
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// PictureType identifies the role of an embedded picture, using the same values as
+// the ID3v2 APIC frame's picture type byte.
+type PictureType uint8
+
+const (
+	PictureTypeOther PictureType = iota
+	PictureTypeFileIcon
+	PictureTypeOtherFileIcon
+	PictureTypeFrontCover
+	PictureTypeBackCover
+	PictureTypeLeafletPage
+	PictureTypeMedia
+	PictureTypeLeadArtist
+	PictureTypeArtist
+	PictureTypeConductor
+	PictureTypeBand
+	PictureTypeComposer
+	PictureTypeLyricist
+	PictureTypeRecordingLocation
+	PictureTypeDuringRecording
+	PictureTypeDuringPerformance
+	PictureTypeVideoCapture
+	PictureTypeFish
+	PictureTypeIllustration
+	PictureTypeBandLogo
+	PictureTypePublisherLogo
+)
+
+// Picture is a single embedded image, along with the metadata TagLib stores
+// alongside it.
+type Picture struct {
+	Type        PictureType
+	MIME        string
+	Description string
+	Data        []byte
+}
+
+// ReadImages reads all embedded pictures from the file at path, in file order. It
+// returns an empty, non-nil slice if the file has no pictures.
+func ReadImages(path string) ([]Picture, error) {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModuleRO(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	var rows []string
+	if err := mod.call("taglib_file_read_pictures", &rows, wasmPath(path)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	if rows == nil {
+		return nil, ErrInvalidFile
+	}
+
+	var data []byte
+	if err := mod.call("taglib_file_read_pictures_data", &data, wasmPath(path), byteArrayLength(4)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+
+	pics := make([]Picture, 0, len(rows))
+	var offset int
+	for _, row := range rows {
+		p, n, err := parsePictureRow(row, data[offset:])
+		if err != nil {
+			return nil, fmt.Errorf("parse picture: %w", err)
+		}
+		pics = append(pics, p)
+		offset += n
+	}
+	return pics, nil
+}
+
+// ReadPictures is an alias for [ReadImages].
+func ReadPictures(path string) ([]Picture, error) {
+	return ReadImages(path)
+}
+
+// WritePictures replaces all embedded pictures in the file at path with pics. The
+// behavior of opts matches [WriteTags].
+func WritePictures(path string, pics []Picture, opts WriteOption) error {
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModule(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("init module: %w", err)
+	}
+	defer mod.close()
+
+	rows, data := encodePictures(pics)
+
+	var out bool
+	if err := mod.call("taglib_file_write_pictures", &out, wasmPath(path), rows, data, uint8(opts)); err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	if !out {
+		return ErrSavingFile
+	}
+	return nil
+}
+
+// RemovePictures removes every embedded picture for which filter returns true,
+// leaving the rest in place.
+func RemovePictures(path string, filter func(Picture) bool) error {
+	pics, err := ReadImages(path)
+	if err != nil {
+		return fmt.Errorf("read existing pictures: %w", err)
+	}
+
+	kept := pics[:0]
+	for _, p := range pics {
+		if !filter(p) {
+			kept = append(kept, p)
+		}
+	}
+
+	return WritePictures(path, kept, Clear)
+}
+
+// parsePictureRow decodes a "type\tmime\tdescription\tlength" metadata row and slices
+// its picture bytes off the front of data, returning the number of bytes consumed.
+func parsePictureRow(row string, data []byte) (Picture, int, error) {
+	fields := strings.SplitN(row, "\t", 4)
+	if len(fields) != 4 {
+		return Picture{}, 0, fmt.Errorf("malformed picture row %q", row)
+	}
+
+	typ, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Picture{}, 0, fmt.Errorf("parse picture type: %w", err)
+	}
+	size, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return Picture{}, 0, fmt.Errorf("parse picture size: %w", err)
+	}
+	if size > len(data) {
+		return Picture{}, 0, fmt.Errorf("picture size %d exceeds remaining data %d", size, len(data))
+	}
+
+	pictureData := make([]byte, size)
+	copy(pictureData, data[:size])
+
+	return Picture{
+		Type:        PictureType(typ),
+		MIME:        fields[1],
+		Description: fields[2],
+		Data:        pictureData,
+	}, size, nil
+}
+
+// encodePictures is the inverse of parsePictureRow, flattening pics into the metadata
+// rows and concatenated byte buffer taglib_file_write_pictures expects.
+func encodePictures(pics []Picture) (rows []string, data []byte) {
+	rows = make([]string, 0, len(pics))
+	for _, p := range pics {
+		rows = append(rows, fmt.Sprintf("%d\t%s\t%s\t%d", p.Type, p.MIME, p.Description, len(p.Data)))
+		data = append(data, p.Data...)
+	}
+	return rows, data
+}
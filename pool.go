@@ -0,0 +1,101 @@
+package taglib
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// maxIdleModules caps how many idle WASM module instances [modulePool] keeps warm per
+// (directory, readonly) pair, configurable via [SetMaxIdleModules]. The default of 4
+// is a small, arbitrary allowance for bursty reuse (e.g. a few goroutines scanning the
+// same directory) without keeping a large number of linear memories resident.
+var maxIdleModules atomic.Int32
+
+func init() {
+	maxIdleModules.Store(4)
+}
+
+// SetMaxIdleModules sets the maximum number of idle WASM module instances [Open] and
+// [OpenReadonly] keep warm, per (directory, readonly) pair, for reuse by later calls
+// against the same directory. Checking a module back in above this limit closes it
+// immediately instead of pooling it. A value of 0 disables pooling entirely. This
+// mainly matters when scanning a large library, where module instantiation otherwise
+// dominates the cost of reading each file's tags.
+func SetMaxIdleModules(n int) {
+	maxIdleModules.Store(int32(n))
+}
+
+// poolKey identifies a pool of modules mounting the same directory with the same
+// access mode.
+type poolKey struct {
+	dir      string
+	readOnly bool
+}
+
+var (
+	poolsMu sync.Mutex
+	pools   = map[poolKey]*modulePool{}
+)
+
+// modulePool holds idle, already-instantiated modules for one (directory, readonly)
+// pair, so that repeated [Open]/[OpenReadonly] calls against the same directory reuse
+// a warmed instance instead of paying instantiation cost per call.
+//
+// idle is a plain mutex-guarded slice rather than a [sync.Pool]: sync.Pool silently
+// drops its contents on GC, which would leave idle's count permanently overstating
+// what's actually pooled (checkin's cap check would then always take the close
+// branch), so an explicit slice is used to keep the occupancy count exact.
+type modulePool struct {
+	key  poolKey
+	mu   sync.Mutex
+	idle []module
+}
+
+// checkoutModule returns an idle module for (dir, readOnly) if one is available,
+// otherwise it instantiates a fresh one. The returned pool must be passed to
+// checkin when the caller is done with the module.
+func checkoutModule(dir string, readOnly bool) (module, *modulePool, error) {
+	key := poolKey{dir: dir, readOnly: readOnly}
+
+	poolsMu.Lock()
+	p, ok := pools[key]
+	if !ok {
+		p = &modulePool{key: key}
+		pools[key] = p
+	}
+	poolsMu.Unlock()
+
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		mod := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return mod, p, nil
+	}
+	p.mu.Unlock()
+
+	mod, err := newModuleOpt(dir, readOnly)
+	return mod, p, err
+}
+
+// checkin returns m to the pool it was checked out from, unless the pool already
+// holds [SetMaxIdleModules]'s worth of idle modules, in which case m is closed
+// instead.
+func (p *modulePool) checkin(m module) {
+	if !p.tryCheckin(m) {
+		m.close()
+	}
+}
+
+// tryCheckin appends m to p.idle and reports true, unless p.idle is already at
+// [SetMaxIdleModules]'s cap, in which case it reports false without modifying p.
+func (p *modulePool) tryCheckin(m module) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.idle) >= int(maxIdleModules.Load()) {
+		return false
+	}
+	p.idle = append(p.idle, m)
+	return true
+}
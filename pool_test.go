@@ -0,0 +1,67 @@
+package taglib
+
+import "testing"
+
+func TestModulePoolCheckinUnderCap(t *testing.T) {
+	old := maxIdleModules.Load()
+	defer maxIdleModules.Store(old)
+	maxIdleModules.Store(4)
+
+	p := &modulePool{key: poolKey{dir: "/tmp/eg", readOnly: true}}
+	m1, m2 := module{}, module{}
+
+	if !p.tryCheckin(m1) {
+		t.Fatal("expected checkin under cap to succeed")
+	}
+	if !p.tryCheckin(m2) {
+		t.Fatal("expected checkin under cap to succeed")
+	}
+	if n := len(p.idle); n != 2 {
+		t.Fatalf("got %d idle modules, want 2", n)
+	}
+}
+
+func TestModulePoolCheckinRespectsCap(t *testing.T) {
+	old := maxIdleModules.Load()
+	defer maxIdleModules.Store(old)
+	maxIdleModules.Store(1)
+
+	p := &modulePool{key: poolKey{dir: "/tmp/eg", readOnly: true}}
+
+	if !p.tryCheckin(module{}) {
+		t.Fatal("expected first checkin under cap to succeed")
+	}
+	if p.tryCheckin(module{}) {
+		t.Fatal("expected second checkin at cap to be rejected")
+	}
+	if n := len(p.idle); n != 1 {
+		t.Fatalf("got %d idle modules, want 1", n)
+	}
+}
+
+func TestModulePoolOccupancyStaysExact(t *testing.T) {
+	// Regression test: idle occupancy must reflect exactly what's in p.idle, not an
+	// independent counter that can drift from the real contents (as it did when this
+	// was backed by a sync.Pool, whose silent GC eviction could leave the counter
+	// permanently overstating what was actually pooled).
+	old := maxIdleModules.Load()
+	defer maxIdleModules.Store(old)
+	maxIdleModules.Store(2)
+
+	p := &modulePool{key: poolKey{dir: "/tmp/eg", readOnly: true}}
+
+	p.tryCheckin(module{})
+	p.tryCheckin(module{})
+
+	p.mu.Lock()
+	n := len(p.idle)
+	p.idle = p.idle[:n-1]
+	p.mu.Unlock()
+
+	if !p.tryCheckin(module{}) {
+		t.Fatal("expected checkin to succeed once occupancy dropped back below the cap")
+	}
+	if n := len(p.idle); n != 2 {
+		t.Fatalf("got %d idle modules, want 2", n)
+	}
+}
@@ -0,0 +1,261 @@
+package taglib
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+)
+
+// readerMemoryThreshold is the largest source [OpenReader] and [OpenReaderAt] will
+// buffer directly into a synthetic in-memory guest filesystem; sources at or above it
+// are spooled to a private temporary file whose directory is mounted instead, the way
+// path-based calls mount a host directory (read-only unless the source is writable).
+// Configurable via
+// [SetReaderMemoryThreshold].
+var readerMemoryThreshold int64 = 32 << 20 // 32 MiB
+
+// SetReaderMemoryThreshold configures the size threshold [OpenReader] and
+// [OpenReaderAt] use to decide between buffering a source in memory and spooling it
+// to a temporary file.
+func SetReaderMemoryThreshold(n int64) {
+	readerMemoryThreshold = n
+}
+
+// OpenReader opens a TagLib-supported stream read entirely from r, without requiring
+// the data to exist as a file on the host filesystem. Sources below
+// [SetReaderMemoryThreshold] are served from memory without mounting any host
+// directory into the WASM guest; larger ones are spooled to a private temporary
+// directory, which is mounted instead. Every write method on the returned [File]
+// returns [ErrReadonly]; use [OpenReaderAt] for a File whose writes can be flushed back.
+func OpenReader(r io.Reader) (*File, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read source: %w", err)
+	}
+	return openReaderData(data, true, nil)
+}
+
+// OpenReaderAt opens a TagLib-supported stream of size bytes read from r. If r
+// additionally implements [io.WriterAt], writes made through the returned [File] are
+// flushed back to r, offset 0, on [File.Close]; otherwise they return [ErrReadonly].
+func OpenReaderAt(r io.ReaderAt, size int64) (*File, error) {
+	data := make([]byte, size)
+	if _, err := r.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read source: %w", err)
+	}
+
+	w, _ := r.(io.WriterAt)
+	return openReaderData(data, w == nil, w)
+}
+
+func openReaderData(data []byte, readonly bool, w io.WriterAt) (*File, error) {
+	format, err := sniffFormat(data)
+	if err != nil {
+		return nil, err
+	}
+	ext, err := format.ext()
+	if err != nil {
+		return nil, err
+	}
+	return openReaderDataExt(data, ext, readonly, w)
+}
+
+// openReaderDataExt is [openReaderData] for a caller that already knows the source's
+// container format (e.g. [ReadTagsFromReader]), skipping the magic-byte sniff.
+func openReaderDataExt(data []byte, ext string, readonly bool, w io.WriterAt) (*File, error) {
+	if int64(len(data)) >= readerMemoryThreshold {
+		return openSpooledFile(data, ext, readonly, w)
+	}
+	return openMemFile(data, ext, readonly, w)
+}
+
+// openMemFile mounts data as a single synthetic file in the WASM guest's root
+// filesystem, so TagLib sees exactly one virtual path instead of a whole host
+// directory, as path-based calls require.
+func openMemFile(data []byte, ext string, readonly bool, w io.WriterAt) (*File, error) {
+	name := "stream" + ext
+
+	buf := &memBuffer{data: data, modTime: time.Now()}
+	fsConfig := wazero.NewFSConfig().WithFSMount(&memFS{name: name, buf: buf}, "/")
+
+	mod, err := instantiateModule(context.Background(), fsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+
+	f := &File{readonly: readonly, mod: mod, path: "/" + name}
+	if w != nil {
+		f.flush = func() error {
+			buf.mu.Lock()
+			defer buf.mu.Unlock()
+			_, err := w.WriteAt(buf.data, 0)
+			return err
+		}
+	}
+	return f, nil
+}
+
+// openSpooledFile spools data to a private temporary file and mounts its parent
+// directory the same way [newModule] does, for sources too large to buffer in WASM
+// memory comfortably.
+func openSpooledFile(data []byte, ext string, readonly bool, w io.WriterAt) (*File, error) {
+	dir, err := os.MkdirTemp("", "go-taglib-reader")
+	if err != nil {
+		return nil, fmt.Errorf("create temp dir: %w", err)
+	}
+
+	path := dir + string(os.PathSeparator) + "stream" + ext
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("spool to temp file: %w", err)
+	}
+
+	mod, err := newModuleOpt(dir, readonly)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+
+	f := &File{readonly: readonly, mod: mod, path: wasmPath(path), cleanup: func() error {
+		return os.RemoveAll(dir)
+	}}
+	if w != nil {
+		f.flush = func() error {
+			written, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("read back spooled file: %w", err)
+			}
+			_, err = w.WriteAt(written, 0)
+			return err
+		}
+	}
+	return f, nil
+}
+
+// memBuffer is the shared, in-memory backing storage for a file mounted by
+// [openMemFile], so that writes the WASM guest makes are visible to Go afterward,
+// e.g. to flush back to an underlying io.WriterAt in [OpenReaderAt].
+type memBuffer struct {
+	mu      sync.Mutex
+	data    []byte
+	modTime time.Time
+}
+
+// memFS is a single-file fs.FS, mounted at the WASM guest's root by [openMemFile] so
+// that TagLib sees exactly one virtual path rather than an entire host directory.
+type memFS struct {
+	name string
+	buf  *memBuffer
+}
+
+func (m *memFS) Open(name string) (fs.File, error) {
+	if name != m.name {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return &memFileHandle{buf: m.buf}, nil
+}
+
+// memFileHandle is an open handle onto a [memFS]'s single file, supporting the
+// read/write/seek operations wazero's sysfs adapter looks for via type assertion.
+type memFileHandle struct {
+	buf *memBuffer
+	pos int64
+}
+
+func (h *memFileHandle) Stat() (fs.FileInfo, error) {
+	h.buf.mu.Lock()
+	defer h.buf.mu.Unlock()
+	return memFileInfo{name: "", size: int64(len(h.buf.data)), modTime: h.buf.modTime}, nil
+}
+
+func (h *memFileHandle) Read(p []byte) (int, error) {
+	h.buf.mu.Lock()
+	defer h.buf.mu.Unlock()
+
+	if h.pos >= int64(len(h.buf.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, h.buf.data[h.pos:])
+	h.pos += int64(n)
+	return n, nil
+}
+
+func (h *memFileHandle) ReadAt(p []byte, off int64) (int, error) {
+	h.buf.mu.Lock()
+	defer h.buf.mu.Unlock()
+
+	if off >= int64(len(h.buf.data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, h.buf.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (h *memFileHandle) Write(p []byte) (int, error) {
+	return h.WriteAt(p, h.pos)
+}
+
+func (h *memFileHandle) WriteAt(p []byte, off int64) (int, error) {
+	h.buf.mu.Lock()
+	defer h.buf.mu.Unlock()
+
+	end := off + int64(len(p))
+	if end > int64(len(h.buf.data)) {
+		grown := make([]byte, end)
+		copy(grown, h.buf.data)
+		h.buf.data = grown
+	}
+	n := copy(h.buf.data[off:end], p)
+	h.pos = off + int64(n)
+	return n, nil
+}
+
+func (h *memFileHandle) Seek(offset int64, whence int) (int64, error) {
+	h.buf.mu.Lock()
+	size := int64(len(h.buf.data))
+	h.buf.mu.Unlock()
+
+	var base int64
+	switch whence {
+	case io.SeekStart:
+		base = 0
+	case io.SeekCurrent:
+		base = h.pos
+	case io.SeekEnd:
+		base = size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	pos := base + offset
+	if pos < 0 {
+		return 0, fmt.Errorf("negative seek position")
+	}
+	h.pos = pos
+	return pos, nil
+}
+
+func (h *memFileHandle) Close() error { return nil }
+
+// memFileInfo implements fs.FileInfo for [memFileHandle.Stat].
+type memFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return i.size }
+func (i memFileInfo) Mode() fs.FileMode  { return 0o600 }
+func (i memFileInfo) ModTime() time.Time { return i.modTime }
+func (i memFileInfo) IsDir() bool        { return false }
+func (i memFileInfo) Sys() any           { return nil }
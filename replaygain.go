@@ -0,0 +1,147 @@
+package taglib
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Raw ReplayGain tag keys, for callers that want the strings directly instead of
+// going through [ReadReplayGain] and [WriteReplayGain].
+const (
+	ReplayGainTrackGain         = "REPLAYGAIN_TRACK_GAIN"
+	ReplayGainTrackPeak         = "REPLAYGAIN_TRACK_PEAK"
+	ReplayGainAlbumGain         = "REPLAYGAIN_ALBUM_GAIN"
+	ReplayGainAlbumPeak         = "REPLAYGAIN_ALBUM_PEAK"
+	ReplayGainReferenceLoudness = "REPLAYGAIN_REFERENCE_LOUDNESS"
+
+	// iTunNORM is the raw MP4 freeform atom name TagLib exposes the SoundCheck atom
+	// under. Unlike ID3 frame IDs, TagLib preserves the atom name's literal case, so
+	// this must be looked up case-insensitively (see [firstTag]).
+	iTunNORM = "----:com.apple.iTunes:iTunNORM"
+)
+
+// ReplayGain is a file's loudness-normalization metadata, as standardized by the
+// ReplayGain proposal and stored as Vorbis comments, ID3v2 TXXX frames, or APEv2
+// items, or (for files tagged by iTunes) decoded from the MP4 SoundCheck atom.
+type ReplayGain struct {
+	TrackGainDB       float64
+	TrackPeak         float64
+	AlbumGainDB       float64
+	AlbumPeak         float64
+	ReferenceLoudness float64
+}
+
+// ReadReplayGain reads the file at path's ReplayGain tags. If the track gain and
+// track peak are both missing or zero and the file carries an MP4 iTunNORM
+// SoundCheck atom, the track gain and peak are decoded from that instead.
+func ReadReplayGain(path string) (ReplayGain, error) {
+	tags, err := ReadTags(path)
+	if err != nil {
+		return ReplayGain{}, err
+	}
+	return replayGainFromTags(tags), nil
+}
+
+// WriteReplayGain writes rg's ReplayGain tags to the file at path. The behavior of
+// opts matches [WriteTags]; note that opts&[Clear] removes every other tag too, not
+// just ReplayGain ones.
+func WriteReplayGain(path string, rg ReplayGain, opts WriteOption) error {
+	tags := map[string][]string{
+		ReplayGainTrackGain: {formatGainDB(rg.TrackGainDB)},
+		ReplayGainTrackPeak: {formatPeak(rg.TrackPeak)},
+		ReplayGainAlbumGain: {formatGainDB(rg.AlbumGainDB)},
+		ReplayGainAlbumPeak: {formatPeak(rg.AlbumPeak)},
+	}
+	if rg.ReferenceLoudness != 0 {
+		tags[ReplayGainReferenceLoudness] = []string{formatGainDB(rg.ReferenceLoudness)}
+	}
+	return WriteTags(path, tags, opts)
+}
+
+func replayGainFromTags(tags map[string][]string) ReplayGain {
+	rg := ReplayGain{
+		TrackGainDB:       parseGainDB(firstTag(tags, ReplayGainTrackGain)),
+		TrackPeak:         parsePeak(firstTag(tags, ReplayGainTrackPeak)),
+		AlbumGainDB:       parseGainDB(firstTag(tags, ReplayGainAlbumGain)),
+		AlbumPeak:         parsePeak(firstTag(tags, ReplayGainAlbumPeak)),
+		ReferenceLoudness: parseGainDB(firstTag(tags, ReplayGainReferenceLoudness)),
+	}
+
+	if rg.TrackGainDB == 0 && rg.TrackPeak == 0 {
+		if norm := firstTag(tags, iTunNORM); norm != "" {
+			if gain, peak, err := parseITunNORM(norm); err == nil {
+				rg.TrackGainDB = gain
+				rg.TrackPeak = peak
+			}
+		}
+	}
+	return rg
+}
+
+// firstTag returns the first value of tags[key], matching key case-insensitively so
+// that format-specific spellings that preserve literal case (MP4 freeform atom names
+// like [iTunNORM], ASF attribute names) still match.
+func firstTag(tags map[string][]string, key string) string {
+	if vs := tags[key]; len(vs) > 0 {
+		return vs[0]
+	}
+	for k, vs := range tags {
+		if strings.EqualFold(k, key) && len(vs) > 0 {
+			return vs[0]
+		}
+	}
+	return ""
+}
+
+// parseGainDB parses a ReplayGain-style gain string such as "-6.75 dB", tolerating a
+// missing or differently-cased suffix.
+func parseGainDB(s string) float64 {
+	s = strings.TrimSpace(s)
+	s = strings.TrimSuffix(strings.TrimSpace(strings.TrimSuffix(s, "dB")), "DB")
+	v, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return v
+}
+
+func formatGainDB(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64) + " dB"
+}
+
+func parsePeak(s string) float64 {
+	v, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return v
+}
+
+func formatPeak(v float64) string {
+	return strconv.FormatFloat(v, 'f', 6, 64)
+}
+
+// parseITunNORM decodes an MP4 "----:com.apple.iTunes:iTunNORM" SoundCheck atom, a
+// space-separated list of ten zero-padded 8-digit hex values. The first pair encodes
+// the stereo downmix gain and peak; this package only surfaces the values relevant to
+// [ReplayGain], using the community-documented SoundCheck scaling of a reference
+// volume of 1000.
+func parseITunNORM(s string) (gainDB, peak float64, err error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return 0, 0, fmt.Errorf("malformed iTunNORM value %q", s)
+	}
+
+	volume, err := strconv.ParseUint(fields[0], 16, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse volume adjustment: %w", err)
+	}
+	peakRaw, err := strconv.ParseUint(fields[1], 16, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse peak: %w", err)
+	}
+
+	if volume == 0 {
+		return 0, 0, fmt.Errorf("zero volume adjustment")
+	}
+
+	gainDB = 10 * math.Log10(1000.0/float64(volume))
+	peak = float64(peakRaw) / 32768.0
+	return gainDB, peak, nil
+}
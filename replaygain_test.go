@@ -0,0 +1,45 @@
+package taglib_test
+
+import (
+	"testing"
+
+	"go.senan.xyz/taglib"
+)
+
+func TestReadWriteReplayGain(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	want := taglib.ReplayGain{
+		TrackGainDB: -6.75,
+		TrackPeak:   0.988357,
+		AlbumGainDB: -7.50,
+		AlbumPeak:   0.992157,
+	}
+	err := taglib.WriteReplayGain(path, want, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := taglib.ReadReplayGain(path)
+	nilErr(t, err)
+	eq(t, got, want)
+}
+
+func TestReadReplayGainFromITunNORM(t *testing.T) {
+	t.Parallel()
+
+	// The real MP4 freeform atom name ("----:com.apple.iTunes:iTunNORM") preserves
+	// its mixed case, unlike an ID3 frame ID, so this must round-trip through an
+	// actual M4A file rather than a bare all-caps key on some other format.
+	path := tmpf(t, egM4a, "eg.m4a")
+	err := taglib.WriteTags(path, map[string][]string{
+		"----:com.apple.iTunes:iTunNORM": {"0000075E 0000075E 0000A6B6 0000A6B6 00007FFF 00007FFF 00008000 00008000 00000000 00000000"},
+	}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := taglib.ReadReplayGain(path)
+	nilErr(t, err)
+	if got.TrackGainDB == 0 {
+		t.Fatalf("expected non-zero gain decoded from iTunNORM, got %v", got)
+	}
+}
@@ -0,0 +1,164 @@
+package taglib
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Session holds one WASM module mounted against a single root directory, so that
+// scanning many files under root reuses the same compiled module and FS mount
+// instead of paying instantiation and mount cost per file, as the package-level
+// functions do.
+//
+// A Session is safe for sequential use from one goroutine. For parallel scans, use a
+// pool of Sessions (e.g. a sync.Pool or a fixed-size worker pool each holding its own
+// Session), rather than sharing one across goroutines.
+type Session struct {
+	root string
+	mod  module
+}
+
+// NewSession opens a Session rooted at root. If readonly is true, write operations
+// on the session return [ErrSavingFile].
+func NewSession(root string, readonly bool) (*Session, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("make root abs %w", err)
+	}
+
+	mod, err := newModuleOpt(root, readonly)
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+
+	return &Session{root: root, mod: mod}, nil
+}
+
+// Close releases the session's WASM module.
+func (s *Session) Close() error {
+	s.mod.close()
+	return nil
+}
+
+func (s *Session) abs(relPath string) string {
+	return wasmPath(filepath.Join(s.root, relPath))
+}
+
+// ReadTags reads all metadata tags from the file at relPath, relative to the
+// session's root.
+func (s *Session) ReadTags(relPath string) (map[string][]string, error) {
+	var raw []string
+	if err := s.mod.call("taglib_file_tags", &raw, s.abs(relPath)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	if raw == nil {
+		return nil, ErrInvalidFile
+	}
+
+	tags := map[string][]string{}
+	for _, row := range raw {
+		k, v, ok := strings.Cut(row, "\t")
+		if !ok {
+			continue
+		}
+		tags[k] = append(tags[k], v)
+	}
+	return tags, nil
+}
+
+// ReadProperties reads the audio properties from the file at relPath, relative to
+// the session's root.
+func (s *Session) ReadProperties(relPath string) (Properties, error) {
+	const (
+		audioPropertyLengthInMilliseconds = iota
+		audioPropertyChannels
+		audioPropertySampleRate
+		audioPropertyBitrate
+		audioPropertyLen
+	)
+
+	raw := make([]int, 0, audioPropertyLen)
+	if err := s.mod.call("taglib_file_audioproperties", &raw, s.abs(relPath)); err != nil {
+		return Properties{}, fmt.Errorf("call: %w", err)
+	}
+
+	return Properties{
+		Length:     time.Duration(raw[audioPropertyLengthInMilliseconds]) * time.Millisecond,
+		Channels:   uint(raw[audioPropertyChannels]),
+		SampleRate: uint(raw[audioPropertySampleRate]),
+		Bitrate:    uint(raw[audioPropertyBitrate]),
+	}, nil
+}
+
+// ReadImageRaw reads the first available embedded image from the file at relPath,
+// relative to the session's root.
+func (s *Session) ReadImageRaw(relPath string) ([]byte, error) {
+	var img []byte
+	if err := s.mod.call("taglib_file_read_image", &img, s.abs(relPath), byteArrayLength(4)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	return img, nil
+}
+
+// WriteTags writes tags to the file at relPath, relative to the session's root. The
+// behavior of opts matches [WriteTags].
+func (s *Session) WriteTags(relPath string, tags map[string][]string, opts WriteOption) error {
+	var raw []string
+	for k, vs := range tags {
+		raw = append(raw, fmt.Sprintf("%s\t%s", k, strings.Join(vs, "\v")))
+	}
+
+	var out bool
+	if err := s.mod.call("taglib_file_write_tags", &out, s.abs(relPath), raw, uint8(opts)); err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	if !out {
+		return ErrSavingFile
+	}
+	return nil
+}
+
+// WalkDir walks root, reading the tags and audio properties of every regular file
+// it finds and invoking fn with the results. It reuses a single read-only [Session]
+// for the whole walk. Files that don't look like TagLib can read ([ErrInvalidFile])
+// are skipped rather than aborting the walk; any other read error, or an error
+// returned by fn, stops the walk and is returned.
+func WalkDir(root string, fn func(path string, tags map[string][]string, props Properties) error) error {
+	sess, err := NewSession(root, true)
+	if err != nil {
+		return fmt.Errorf("new session: %w", err)
+	}
+	defer sess.Close()
+
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("rel path: %w", err)
+		}
+
+		tags, err := sess.ReadTags(rel)
+		if err != nil {
+			if err == ErrInvalidFile {
+				return nil
+			}
+			return fmt.Errorf("read tags %q: %w", path, err)
+		}
+
+		props, err := sess.ReadProperties(rel)
+		if err != nil {
+			return fmt.Errorf("read properties %q: %w", path, err)
+		}
+
+		return fn(path, tags, props)
+	})
+}
@@ -0,0 +1,120 @@
+package taglib
+
+import (
+	"fmt"
+	"io"
+)
+
+// Format identifies the container format of a stream passed to [ReadTagsFromReader]
+// or [WriteTagsToReadWriteSeeker], since those sources have no file extension for
+// TagLib to sniff the format from.
+type Format uint8
+
+const (
+	FormatMP3 Format = iota + 1
+	FormatFLAC
+	FormatMP4
+	FormatOGG
+	FormatOGGFLAC
+	FormatOGGOpus
+	FormatWAV
+	FormatAIFF
+	FormatAPE
+	FormatWMA
+)
+
+// ext returns the file extension TagLib's format sniffing expects for f.
+func (f Format) ext() (string, error) {
+	switch f {
+	case FormatMP3:
+		return ".mp3", nil
+	case FormatFLAC:
+		return ".flac", nil
+	case FormatMP4:
+		return ".m4a", nil
+	case FormatOGG:
+		return ".ogg", nil
+	case FormatOGGFLAC:
+		return ".oga", nil
+	case FormatOGGOpus:
+		return ".opus", nil
+	case FormatWAV:
+		return ".wav", nil
+	case FormatAIFF:
+		return ".aiff", nil
+	case FormatAPE:
+		return ".ape", nil
+	case FormatWMA:
+		return ".wma", nil
+	default:
+		return "", fmt.Errorf("unknown format %d", f)
+	}
+}
+
+// ReadTagsFromReader reads metadata tags from r, a stream of size bytes in the given
+// format. Unlike [ReadTags], the source need not exist on the host filesystem; like
+// [OpenReader], it's buffered into a synthetic in-memory guest filesystem rather than
+// spilled to a host temporary file, unless it's at or above
+// [SetReaderMemoryThreshold].
+func ReadTagsFromReader(r io.ReaderAt, size int64, format Format) (map[string][]string, error) {
+	ext, err := format.ext()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, size)
+	if _, err := r.ReadAt(data, 0); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("read source: %w", err)
+	}
+
+	f, err := openReaderDataExt(data, ext, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open stream: %w", err)
+	}
+	defer f.Close()
+
+	return f.ReadTags()
+}
+
+// WriteTagsToReadWriteSeeker writes tags to rws, a stream of size bytes in the given
+// format, and writes the resulting file back to rws starting at offset 0. Like
+// [ReadTagsFromReader], the source is buffered in memory rather than spilled to a host
+// temporary file, unless it's at or above [SetReaderMemoryThreshold]. The behavior of
+// opts matches [WriteTags].
+func WriteTagsToReadWriteSeeker(rws io.ReadWriteSeeker, size int64, tags map[string][]string, format Format, opts WriteOption) error {
+	ext, err := format.ext()
+	if err != nil {
+		return err
+	}
+
+	if _, err := rws.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek to start: %w", err)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(rws, data); err != nil {
+		return fmt.Errorf("read existing contents: %w", err)
+	}
+
+	f, err := openReaderDataExt(data, ext, false, seekWriterAt{rws})
+	if err != nil {
+		return fmt.Errorf("open stream: %w", err)
+	}
+	defer f.Close()
+
+	return f.WriteTags(tags, opts)
+}
+
+// seekWriterAt adapts an io.WriteSeeker into the io.WriterAt [openReaderDataExt]
+// expects for flushing writes back to the source, for callers (like
+// [WriteTagsToReadWriteSeeker]) whose destination doesn't implement WriteAt itself.
+type seekWriterAt struct {
+	io.WriteSeeker
+}
+
+func (s seekWriterAt) WriteAt(p []byte, off int64) (int, error) {
+	if _, err := s.Seek(off, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return s.Write(p)
+}
@@ -0,0 +1,220 @@
+package taglib
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Tagger abstracts reading and writing tags, audio properties, and pictures so that
+// callers can swap in an alternate backend — a pure-Go fallback, a mock for tests, or
+// a caching decorator — without forking this package. [WasmTagger] is the default
+// implementation, backed by the WASM-compiled TagLib used throughout this package.
+//
+// To build a session-backed Tagger for high-throughput scans of many files under one
+// directory, wrap a [Session] and translate its relative-path methods to the absolute
+// paths this interface expects; unlike the package-level functions and [WasmTagger],
+// a single Session reuses one module instance and FS mount across calls.
+type Tagger interface {
+	ReadTags(path string) (map[string][]string, error)
+	ReadProperties(path string) (Properties, error)
+	ReadImages(path string) ([]Picture, error)
+	WriteTags(path string, tags map[string][]string, opts WriteOption) error
+	WriteImages(path string, pics []Picture, opts WriteOption) error
+	Close() error
+}
+
+// WasmTagger is the default [Tagger], implemented by this package's WASM-compiled
+// TagLib. It holds no state, so the zero value is ready to use.
+type WasmTagger struct{}
+
+func (WasmTagger) ReadTags(path string) (map[string][]string, error) { return ReadTags(path) }
+func (WasmTagger) ReadProperties(path string) (Properties, error)    { return ReadProperties(path) }
+func (WasmTagger) ReadImages(path string) ([]Picture, error)         { return ReadImages(path) }
+
+func (WasmTagger) WriteTags(path string, tags map[string][]string, opts WriteOption) error {
+	return WriteTags(path, tags, opts)
+}
+
+func (WasmTagger) WriteImages(path string, pics []Picture, opts WriteOption) error {
+	return WritePictures(path, pics, opts)
+}
+
+func (WasmTagger) Close() error { return nil }
+
+// MultiTagger tries each backend in order, returning the first successful result. It
+// is itself a [Tagger], typically used with [WasmTagger] as a final fallback behind
+// one or more alternate backends.
+type MultiTagger []Tagger
+
+func (m MultiTagger) ReadTags(path string) (map[string][]string, error) {
+	return firstOK(m, func(t Tagger) (map[string][]string, error) { return t.ReadTags(path) })
+}
+
+func (m MultiTagger) ReadProperties(path string) (Properties, error) {
+	return firstOK(m, func(t Tagger) (Properties, error) { return t.ReadProperties(path) })
+}
+
+func (m MultiTagger) ReadImages(path string) ([]Picture, error) {
+	return firstOK(m, func(t Tagger) ([]Picture, error) { return t.ReadImages(path) })
+}
+
+func (m MultiTagger) WriteTags(path string, tags map[string][]string, opts WriteOption) error {
+	_, err := firstOK(m, func(t Tagger) (struct{}, error) { return struct{}{}, t.WriteTags(path, tags, opts) })
+	return err
+}
+
+func (m MultiTagger) WriteImages(path string, pics []Picture, opts WriteOption) error {
+	_, err := firstOK(m, func(t Tagger) (struct{}, error) { return struct{}{}, t.WriteImages(path, pics, opts) })
+	return err
+}
+
+// Close closes every backend, returning the first error encountered, if any.
+func (m MultiTagger) Close() error {
+	var first error
+	for _, t := range m {
+		if err := t.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// firstOK runs call against each of taggers in order, returning the first result that
+// succeeds. If every backend fails, it returns the last backend's error.
+func firstOK[T any](taggers []Tagger, call func(Tagger) (T, error)) (T, error) {
+	var (
+		zero T
+		err  error
+	)
+	if len(taggers) == 0 {
+		return zero, fmt.Errorf("no backends configured")
+	}
+
+	for _, t := range taggers {
+		var v T
+		v, err = call(t)
+		if err == nil {
+			return v, nil
+		}
+	}
+	return zero, err
+}
+
+// cacheKey identifies a file's content by path, modification time, and size, so that
+// [CachingTagger] can detect when a cached entry is stale without re-parsing the file.
+type cacheKey struct {
+	path string
+	mod  int64
+	size int64
+}
+
+// CachingTagger wraps another [Tagger], caching ReadTags and ReadProperties results
+// keyed on (path, mtime, size) so that repeated scans of an unchanged file don't
+// re-invoke the underlying backend. Writes invalidate the cache entry for their path.
+// It is safe for concurrent use.
+type CachingTagger struct {
+	Tagger
+
+	mu    sync.Mutex
+	tags  map[cacheKey]map[string][]string
+	props map[cacheKey]Properties
+}
+
+// NewCachingTagger wraps tagger with a cache for ReadTags and ReadProperties.
+func NewCachingTagger(tagger Tagger) *CachingTagger {
+	return &CachingTagger{
+		Tagger: tagger,
+		tags:   map[cacheKey]map[string][]string{},
+		props:  map[cacheKey]Properties{},
+	}
+}
+
+func (c *CachingTagger) key(path string) (cacheKey, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return cacheKey{}, fmt.Errorf("stat: %w", err)
+	}
+	return cacheKey{path: path, mod: info.ModTime().UnixNano(), size: info.Size()}, nil
+}
+
+func (c *CachingTagger) ReadTags(path string) (map[string][]string, error) {
+	key, err := c.key(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	cached, ok := c.tags[key]
+	c.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	tags, err := c.Tagger.ReadTags(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.tags[key] = tags
+	c.mu.Unlock()
+	return tags, nil
+}
+
+func (c *CachingTagger) ReadProperties(path string) (Properties, error) {
+	key, err := c.key(path)
+	if err != nil {
+		return Properties{}, err
+	}
+
+	c.mu.Lock()
+	cached, ok := c.props[key]
+	c.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	props, err := c.Tagger.ReadProperties(path)
+	if err != nil {
+		return Properties{}, err
+	}
+
+	c.mu.Lock()
+	c.props[key] = props
+	c.mu.Unlock()
+	return props, nil
+}
+
+func (c *CachingTagger) WriteTags(path string, tags map[string][]string, opts WriteOption) error {
+	if err := c.Tagger.WriteTags(path, tags, opts); err != nil {
+		return err
+	}
+	c.invalidate(path)
+	return nil
+}
+
+func (c *CachingTagger) WriteImages(path string, pics []Picture, opts WriteOption) error {
+	if err := c.Tagger.WriteImages(path, pics, opts); err != nil {
+		return err
+	}
+	c.invalidate(path)
+	return nil
+}
+
+// invalidate drops every cache entry for path, regardless of the (now possibly stale)
+// mtime/size it was stored under.
+func (c *CachingTagger) invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.tags {
+		if k.path == path {
+			delete(c.tags, k)
+		}
+	}
+	for k := range c.props {
+		if k.path == path {
+			delete(c.props, k)
+		}
+	}
+}
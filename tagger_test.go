@@ -0,0 +1,97 @@
+package taglib_test
+
+import (
+	"errors"
+	"testing"
+
+	"go.senan.xyz/taglib"
+)
+
+func TestWasmTagger(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	var tagger taglib.WasmTagger
+	err := tagger.WriteTags(path, map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := tagger.ReadTags(path)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+
+	nilErr(t, tagger.Close())
+}
+
+type mockTagger struct {
+	tags map[string][]string
+	err  error
+}
+
+func (m mockTagger) ReadTags(string) (map[string][]string, error) { return m.tags, m.err }
+func (mockTagger) ReadProperties(string) (taglib.Properties, error) {
+	return taglib.Properties{}, errors.ErrUnsupported
+}
+func (mockTagger) ReadImages(string) ([]taglib.Picture, error) { return nil, errors.ErrUnsupported }
+func (mockTagger) WriteTags(string, map[string][]string, taglib.WriteOption) error {
+	return errors.ErrUnsupported
+}
+func (mockTagger) WriteImages(string, []taglib.Picture, taglib.WriteOption) error {
+	return errors.ErrUnsupported
+}
+func (mockTagger) Close() error { return nil }
+
+func TestMultiTagger(t *testing.T) {
+	t.Parallel()
+
+	failing := mockTagger{err: errors.New("backend unavailable")}
+	working := mockTagger{tags: map[string][]string{"ARTIST": {"Example A"}}}
+
+	multi := taglib.MultiTagger{failing, working}
+	got, err := multi.ReadTags("unused")
+	nilErr(t, err)
+	tagEq(t, got, working.tags)
+
+	_, err = taglib.MultiTagger{failing}.ReadTags("unused")
+	if err == nil {
+		t.Fatal("expected error when every backend fails")
+	}
+}
+
+func TestCachingTagger(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+	err := taglib.WriteTags(path, map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	calls := 0
+	counting := countingTagger{WasmTagger: taglib.WasmTagger{}, calls: &calls}
+	cache := taglib.NewCachingTagger(counting)
+
+	got, err := cache.ReadTags(path)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+
+	_, err = cache.ReadTags(path)
+	nilErr(t, err)
+	eq(t, calls, 1)
+
+	err = cache.WriteTags(path, map[string][]string{"ARTIST": {"Example B"}}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err = cache.ReadTags(path)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example B"}})
+	eq(t, calls, 2)
+}
+
+type countingTagger struct {
+	taglib.WasmTagger
+	calls *int
+}
+
+func (c countingTagger) ReadTags(path string) (map[string][]string, error) {
+	*c.calls++
+	return c.WasmTagger.ReadTags(path)
+}
@@ -143,8 +143,35 @@ const (
 	Work                      = "WORK"
 )
 
-// ReadTags reads all metadata tags from an audio file at the given path.
+// tagsFlight and propsFlight coalesce concurrent [ReadTags]/[ReadProperties] calls
+// against the same path into a single WASM invocation, so a burst of requests for one
+// file (e.g. a media server under load) share the result instead of each paying for
+// their own module instantiation. [ReadTagsContext]/[ReadPropertiesContext] and
+// [Open]/[OpenReadonly] are unaffected; only these convenience helpers coalesce.
+var (
+	tagsFlight  flightGroup[map[string][]string]
+	propsFlight flightGroup[Properties]
+)
+
+// ReadTags reads all metadata tags from an audio file at the given path. Concurrent
+// calls against the same path coalesce into a single underlying read; see
+// [ReadTagsContext] for a version that doesn't.
 func ReadTags(path string) (map[string][]string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("make path abs %w", err)
+	}
+
+	tags, err, _ := tagsFlight.do(abs, func() (map[string][]string, error) {
+		return ReadTagsContext(context.Background(), abs)
+	})
+	return tags, err
+}
+
+// ReadTagsContext is like [ReadTags], but runs module instantiation and the
+// underlying call under ctx, so a caller can cancel a stuck read (e.g. on a
+// pathological file) via [context.WithTimeout] instead of blocking forever.
+func ReadTagsContext(ctx context.Context, path string) (map[string][]string, error) {
 	var err error
 	path, err = filepath.Abs(path)
 	if err != nil {
@@ -152,7 +179,7 @@ func ReadTags(path string) (map[string][]string, error) {
 	}
 
 	dir := filepath.Dir(path)
-	mod, err := newModuleRO(dir)
+	mod, err := newModuleContext(ctx, dir, true)
 	if err != nil {
 		return nil, fmt.Errorf("init module: %w", err)
 	}
@@ -190,7 +217,23 @@ type Properties struct {
 }
 
 // ReadProperties reads the audio properties from a file at the given path.
+// Concurrent calls against the same path coalesce into a single underlying read; see
+// [ReadPropertiesContext] for a version that doesn't.
 func ReadProperties(path string) (Properties, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return Properties{}, fmt.Errorf("make path abs %w", err)
+	}
+
+	props, err, _ := propsFlight.do(abs, func() (Properties, error) {
+		return ReadPropertiesContext(context.Background(), abs)
+	})
+	return props, err
+}
+
+// ReadPropertiesContext is like [ReadProperties], but runs module instantiation and
+// the underlying call under ctx; see [ReadTagsContext].
+func ReadPropertiesContext(ctx context.Context, path string) (Properties, error) {
 	var err error
 	path, err = filepath.Abs(path)
 	if err != nil {
@@ -198,7 +241,7 @@ func ReadProperties(path string) (Properties, error) {
 	}
 
 	dir := filepath.Dir(path)
-	mod, err := newModuleRO(dir)
+	mod, err := newModuleContext(ctx, dir, true)
 	if err != nil {
 		return Properties{}, fmt.Errorf("init module: %w", err)
 	}
@@ -229,26 +272,32 @@ func ReadProperties(path string) (Properties, error) {
 // This needs to be a unique type, otherwise a passed uint32 is ambiguous about whether it is for a byte array or just a regular integer
 type byteArrayLength uint32
 
-// ReadImageRaw reads the first available embedded image bytes from path, returning nil if there are no images in the file
+// ReadImageRaw reads the front cover's image bytes from path, falling back to
+// whichever embedded picture comes first if there's no picture explicitly marked as
+// the front cover, and returning an empty reader if there are no images in the file.
+//
+// It is a thin wrapper over [ReadImages]; use that directly to see every embedded
+// picture, not just the cover.
 func ReadImageRaw(path string) (io.Reader, error) {
-	var err error
-	path, err = filepath.Abs(path)
+	pics, err := ReadImages(path)
 	if err != nil {
-		return nil, fmt.Errorf("make path abs %w", err)
+		return nil, err
 	}
+	return bytes.NewReader(frontCover(pics).Data), nil
+}
 
-	mod, err := newModuleRO(filepath.Dir(path))
-	if err != nil {
-		return nil, fmt.Errorf("init module: %w", err)
+// frontCover returns the picture explicitly typed as the front cover, or the first
+// picture if none is, or the zero Picture if pics is empty.
+func frontCover(pics []Picture) Picture {
+	for _, p := range pics {
+		if p.Type == PictureTypeFrontCover {
+			return p
+		}
 	}
-	defer mod.close()
-
-	var img []byte
-	if err := mod.call("taglib_file_read_image", &img, wasmPath(path), byteArrayLength(4)); err != nil {
-		return nil, fmt.Errorf("call: %w", err)
+	if len(pics) > 0 {
+		return pics[0]
 	}
-
-	return bytes.NewReader(img), nil
+	return Picture{}
 }
 
 // ReadImage reads the first available embedded image from path, returning nil if there are no images in the file
@@ -341,6 +390,12 @@ const (
 
 // WriteTags writes the metadata key-values pairs to path. The behavior can be controlled with [WriteOption].
 func WriteTags(path string, tags map[string][]string, opts WriteOption) error {
+	return WriteTagsContext(context.Background(), path, tags, opts)
+}
+
+// WriteTagsContext is like [WriteTags], but runs module instantiation and the
+// underlying call under ctx; see [ReadTagsContext].
+func WriteTagsContext(ctx context.Context, path string, tags map[string][]string, opts WriteOption) error {
 	var err error
 	path, err = filepath.Abs(path)
 	if err != nil {
@@ -348,7 +403,7 @@ func WriteTags(path string, tags map[string][]string, opts WriteOption) error {
 	}
 
 	dir := filepath.Dir(path)
-	mod, err := newModule(dir)
+	mod, err := newModuleContext(ctx, dir, false)
 	if err != nil {
 		return fmt.Errorf("init module: %w", err)
 	}
@@ -374,18 +429,66 @@ type rc struct {
 	wazero.CompiledModule
 }
 
+// wasmCacheDir overrides where the compiled WASM module's machine code is cached on
+// disk, set via [SetCompilationCacheDir]. Empty means unset.
+var wasmCacheDir string
+
+// SetCompilationCacheDir configures a persistent directory for the compiled WASM
+// module's machine-code cache, so that subsequent processes skip recompiling the
+// embedded TagLib binary from scratch. wazero versions cache entries by the compiled
+// module's content hash, so a stale entry from an older taglib.wasm is ignored and
+// regenerated automatically rather than served incorrectly.
+//
+// It must be called before the first call into this package that touches a WASM
+// module (e.g. [ReadTags]); the runtime and its cache are initialized once, lazily,
+// on first use. If dir can't be created or used, the package falls back to its
+// default location under [os.TempDir].
+//
+// Without a call to SetCompilationCacheDir, the TAGLIB_WASM_CACHE_DIR environment
+// variable is used instead, if set.
+func SetCompilationCacheDir(dir string) {
+	wasmCacheDir = dir
+}
+
+// wasmCacheDirDefault is used when no cache directory has been configured, or the
+// configured one can't be used.
+func wasmCacheDirDefault() string {
+	return filepath.Join(os.TempDir(), "go-taglib-wasm")
+}
+
+// resolveWASMCacheDir picks the directory to cache compiled WASM machine code in,
+// preferring [SetCompilationCacheDir], then the TAGLIB_WASM_CACHE_DIR environment
+// variable, and falling back to a temp directory if the configured one is unusable.
+func resolveWASMCacheDir() string {
+	dir := wasmCacheDir
+	if dir == "" {
+		dir = os.Getenv("TAGLIB_WASM_CACHE_DIR")
+	}
+	if dir == "" {
+		return wasmCacheDirDefault()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return wasmCacheDirDefault()
+	}
+	return dir
+}
+
 var getRuntimeOnce = sync.OnceValues(func() (rc, error) {
 	ctx := context.Background()
 
-	cacheDir := filepath.Join(os.TempDir(), "go-taglib-wasm")
+	cacheDir := resolveWASMCacheDir()
 	compilationCache, err := wazero.NewCompilationCacheWithDir(cacheDir)
+	if err != nil && cacheDir != wasmCacheDirDefault() {
+		compilationCache, err = wazero.NewCompilationCacheWithDir(wasmCacheDirDefault())
+	}
 	if err != nil {
 		return rc{}, err
 	}
 
 	runtime := wazero.NewRuntimeWithConfig(ctx,
 		wazero.NewRuntimeConfig().
-			WithCompilationCache(compilationCache),
+			WithCompilationCache(compilationCache).
+			WithCloseOnContextDone(true),
 	)
 	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
 
@@ -418,24 +521,43 @@ var getRuntimeOnce = sync.OnceValues(func() (rc, error) {
 	}, nil
 })
 
+// module wraps a single WASM module instance. ctx is the context passed to
+// instantiateModule; every call and the eventual close run under it, so that a
+// caller's cancellation or deadline (see [newModuleContext]) tears the instance down
+// instead of blocking forever on a stuck TagLib call.
 type module struct {
 	mod api.Module
+	ctx context.Context
 }
 
 func newModule(dir string) (module, error)   { return newModuleOpt(dir, false) }
 func newModuleRO(dir string) (module, error) { return newModuleOpt(dir, true) }
 func newModuleOpt(dir string, readOnly bool) (module, error) {
-	rt, err := getRuntimeOnce()
-	if err != nil {
-		return module{}, fmt.Errorf("get runtime once: %w", err)
-	}
+	return newModuleContext(context.Background(), dir, readOnly)
+}
 
+// newModuleContext is like [newModuleOpt], but runs module instantiation and every
+// subsequent call under ctx, so a caller can cancel a stuck operation (e.g. via
+// [context.WithTimeout]) and have the WASM instance torn down instead of leaking a
+// blocked goroutine.
+func newModuleContext(ctx context.Context, dir string, readOnly bool) (module, error) {
 	fsConfig := wazero.NewFSConfig()
 	if readOnly {
 		fsConfig = fsConfig.WithReadOnlyDirMount(dir, wasmPath(dir))
 	} else {
 		fsConfig = fsConfig.WithDirMount(dir, wasmPath(dir))
 	}
+	return instantiateModule(ctx, fsConfig)
+}
+
+// instantiateModule starts a fresh WASM module instance against fsConfig, shared by
+// every newModule* variant and by [OpenReader]/[OpenReaderAt], which mount a
+// synthetic single-file FS instead of a host directory.
+func instantiateModule(ctx context.Context, fsConfig wazero.FSConfig) (module, error) {
+	rt, err := getRuntimeOnce()
+	if err != nil {
+		return module{}, fmt.Errorf("get runtime once: %w", err)
+	}
 
 	cfg := wazero.
 		NewModuleConfig().
@@ -443,7 +565,6 @@ func newModuleOpt(dir string, readOnly bool) (module, error) {
 		WithStartFunctions("_initialize").
 		WithFSConfig(fsConfig)
 
-	ctx := context.Background()
 	mod, err := rt.Runtime.InstantiateModule(ctx, rt.CompiledModule, cfg)
 	if err != nil {
 		return module{}, err
@@ -451,6 +572,7 @@ func newModuleOpt(dir string, readOnly bool) (module, error) {
 
 	return module{
 		mod: mod,
+		ctx: ctx,
 	}, nil
 }
 
@@ -501,7 +623,7 @@ func (m *module) call(name string, dest any, args ...any) error {
 		}
 	}
 
-	results, err := m.mod.ExportedFunction(name).Call(context.Background(), params...)
+	results, err := m.mod.ExportedFunction(name).Call(m.context(), params...)
 	if err != nil {
 		return fmt.Errorf("call %q: %w", name, err)
 	}
@@ -542,11 +664,21 @@ func (m *module) call(name string, dest any, args ...any) error {
 }
 
 func (m *module) close() {
-	if err := m.mod.Close(context.Background()); err != nil {
+	if err := m.mod.Close(m.context()); err != nil {
 		panic(err)
 	}
 }
 
+// context returns the context module calls run under, defaulting to
+// [context.Background] for a zero-value module (e.g. one returned alongside an
+// error).
+func (m *module) context() context.Context {
+	if m.ctx == nil {
+		return context.Background()
+	}
+	return m.ctx
+}
+
 func makeByteArray(m *module, b []byte) uint32 {
 	ptr := m.malloc(uint32(len(b)))
 	if !m.mod.Memory().Write(ptr, b) {
@@ -1,36 +1,61 @@
 package taglib
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
+	"time"
 )
 
+// ErrReadonly is returned by a write method on a [File] opened read-only, e.g. via
+// [OpenReadonly] or [OpenReader].
 var ErrReadonly = fmt.Errorf("attempted write on read-only file")
 
+// File is a single TagLib-supported file opened for repeated reads and/or writes,
+// reusing one WASM module instance instead of paying instantiation cost per call as
+// the package-level functions do. Like [Session], a File is safe for sequential use
+// from one goroutine only.
 type File struct {
 	readonly bool
-	mod      *module
+	mod      module
 	path     string
+
+	// cleanup, if set, removes any temporary storage backing the file (e.g. a
+	// spooled copy made by [OpenReader]/[OpenReaderAt]).
+	cleanup func() error
+	// flush, if set, writes the file's current contents back to the source it was
+	// opened from (used by [OpenReaderAt]).
+	flush func() error
+	// pool, if set, is where Close returns mod instead of closing it. Only set for
+	// files opened via [Open]/[OpenReadonly] against a real directory; a pooled
+	// module always runs under context.Background(), so it isn't used for the
+	// Context-aware opens or the reader-backed ones.
+	pool *modulePool
 }
 
-// Opens a TagLib supported file
+// Open opens a TagLib-supported file at path for reading and writing. Repeated Open
+// calls against files in the same directory reuse a warmed WASM module instance; see
+// [SetMaxIdleModules].
 func Open(path string) (*File, error) {
-	return open(path, false)
+	return openPooled(path, false)
 }
 
-// Opens a TagLib supported file in read-only mode.
-// Any write operations will return an error of type ErrReadonly
+// OpenReadonly opens a TagLib-supported file at path for reading only. Any write
+// method on the returned File returns [ErrReadonly]. Like [Open], it reuses pooled
+// module instances; see [SetMaxIdleModules].
 func OpenReadonly(path string) (*File, error) {
-	return open(path, true)
+	return openPooled(path, true)
 }
 
-func open(p string, readonly bool) (*File, error) {
+func openPooled(p string, readonly bool) (*File, error) {
 	path, err := filepath.Abs(p)
 	if err != nil {
 		return nil, fmt.Errorf("make path abs %w", err)
 	}
 
-	mod, err := newModule(filepath.Dir(path), readonly)
+	dir := filepath.Dir(path)
+	mod, pool, err := checkoutModule(dir, readonly)
 	if err != nil {
 		return nil, fmt.Errorf("init module: %w", err)
 	}
@@ -39,9 +64,137 @@ func open(p string, readonly bool) (*File, error) {
 		readonly: readonly,
 		mod:      mod,
 		path:     wasmPath(path),
+		pool:     pool,
 	}, nil
 }
 
+// OpenContext is like [Open], but runs the file's module instantiation and every
+// subsequent call (including [File.Close]) under ctx, so a caller can cancel a stuck
+// operation via e.g. [context.WithTimeout] and have the WASM instance torn down
+// instead of leaking a blocked goroutine.
+func OpenContext(ctx context.Context, path string) (*File, error) {
+	return open(ctx, path, false)
+}
+
+// OpenReadonlyContext is the read-only, context-aware combination of [OpenReadonly]
+// and [OpenContext].
+func OpenReadonlyContext(ctx context.Context, path string) (*File, error) {
+	return open(ctx, path, true)
+}
+
+func open(ctx context.Context, p string, readonly bool) (*File, error) {
+	path, err := filepath.Abs(p)
+	if err != nil {
+		return nil, fmt.Errorf("make path abs %w", err)
+	}
+
+	mod, err := newModuleContext(ctx, filepath.Dir(path), readonly)
+	if err != nil {
+		return nil, fmt.Errorf("init module: %w", err)
+	}
+
+	return &File{
+		readonly: readonly,
+		mod:      mod,
+		path:     wasmPath(path),
+	}, nil
+}
+
+// Close releases the file's WASM module. If the file was opened via [OpenReaderAt],
+// any writes are first flushed back to the underlying source. If the file was opened
+// via [Open]/[OpenReadonly], the module is returned to its pool for reuse rather than
+// torn down; see [SetMaxIdleModules].
 func (f *File) Close() error {
-	return f.mod.close()
+	var flushErr error
+	if f.flush != nil {
+		flushErr = f.flush()
+	}
+
+	if f.pool != nil {
+		f.pool.checkin(f.mod)
+	} else {
+		f.mod.close()
+	}
+
+	if f.cleanup != nil {
+		if err := f.cleanup(); err != nil && flushErr == nil {
+			flushErr = err
+		}
+	}
+	return flushErr
+}
+
+// ReadTags reads all metadata tags from the file.
+func (f *File) ReadTags() (map[string][]string, error) {
+	var raw []string
+	if err := f.mod.call("taglib_file_tags", &raw, f.path); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	if raw == nil {
+		return nil, ErrInvalidFile
+	}
+
+	tags := map[string][]string{}
+	for _, row := range raw {
+		k, v, ok := strings.Cut(row, "\t")
+		if !ok {
+			continue
+		}
+		tags[k] = append(tags[k], v)
+	}
+	return tags, nil
+}
+
+// ReadProperties reads the file's audio properties.
+func (f *File) ReadProperties() (Properties, error) {
+	const (
+		audioPropertyLengthInMilliseconds = iota
+		audioPropertyChannels
+		audioPropertySampleRate
+		audioPropertyBitrate
+		audioPropertyLen
+	)
+
+	raw := make([]int, 0, audioPropertyLen)
+	if err := f.mod.call("taglib_file_audioproperties", &raw, f.path); err != nil {
+		return Properties{}, fmt.Errorf("call: %w", err)
+	}
+
+	return Properties{
+		Length:     time.Duration(raw[audioPropertyLengthInMilliseconds]) * time.Millisecond,
+		Channels:   uint(raw[audioPropertyChannels]),
+		SampleRate: uint(raw[audioPropertySampleRate]),
+		Bitrate:    uint(raw[audioPropertyBitrate]),
+	}, nil
+}
+
+// ReadImageRaw reads the first available embedded image from the file.
+func (f *File) ReadImageRaw() ([]byte, error) {
+	var img []byte
+	if err := f.mod.call("taglib_file_read_image", &img, f.path, byteArrayLength(4)); err != nil {
+		return nil, fmt.Errorf("call: %w", err)
+	}
+	return img, nil
+}
+
+// WriteTags writes tags to the file. The behavior of opts matches [WriteTags]. It
+// returns [ErrReadonly] if the file was opened read-only.
+func (f *File) WriteTags(tags map[string][]string, opts WriteOption) error {
+	if f.readonly {
+		return ErrReadonly
+	}
+
+	var raw []string
+	for k, vs := range tags {
+		raw = append(raw, fmt.Sprintf("%s\t%s", k, strings.Join(vs, "\v")))
+	}
+
+	var out bool
+	if err := f.mod.call("taglib_file_write_tags", &out, f.path, raw, uint8(opts)); err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	if !out {
+		return ErrSavingFile
+	}
+	return nil
 }
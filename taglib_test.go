@@ -1,6 +1,7 @@
 package taglib_test
 
 import (
+	"bytes"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -214,6 +215,140 @@ func TestConcurrent(t *testing.T) {
 	nilErr(t, err)
 }
 
+func TestReadTagsBatch(t *testing.T) {
+	t.Parallel()
+
+	paths := testPaths(t)
+	for _, path := range paths {
+		err := taglib.WriteTags(path, map[string][]string{
+			"ARTIST": {"Example A"},
+		}, taglib.Clear)
+		nilErr(t, err)
+	}
+
+	got, err := taglib.ReadTagsBatch(paths)
+	nilErr(t, err)
+
+	eq(t, len(got), len(paths))
+	for _, path := range paths {
+		tagEq(t, got[path], map[string][]string{"ARTIST": {"Example A"}})
+	}
+}
+
+func TestReadTagsBatchInvalid(t *testing.T) {
+	t.Parallel()
+
+	paths := testPaths(t)
+	bad := tmpf(t, []byte("not a file"), "eg.flac")
+	paths = append(paths, bad)
+
+	got, err := taglib.ReadTagsBatch(paths)
+	if err == nil {
+		t.Fatalf("expected error for invalid file")
+	}
+	if _, ok := got[bad]; ok {
+		t.Fatalf("expected no entry for invalid file")
+	}
+	eq(t, len(got), len(paths)-1)
+}
+
+func TestReadPropertiesBatch(t *testing.T) {
+	t.Parallel()
+
+	// two different parent directories, to exercise grouping by dir
+	paths := []string{tmpf(t, egFLAC, "eg.flac"), tmpf(t, egFLAC, "eg.flac")}
+	got, err := taglib.ReadPropertiesBatch(paths)
+	nilErr(t, err)
+
+	for _, path := range paths {
+		eq(t, 1*time.Second, got[path].Length)
+	}
+}
+
+func TestWriteTagsBatch(t *testing.T) {
+	t.Parallel()
+
+	abs := tmpf(t, egFLAC, "eg.flac")
+
+	wd, err := os.Getwd()
+	nilErr(t, err)
+	rel, err := filepath.Rel(wd, abs)
+	nilErr(t, err)
+
+	// Key by a relative path, distinct from the absolute one withModulesByDir
+	// normalizes internally, to catch a lookup keyed by the wrong one.
+	err = taglib.WriteTagsBatch(map[string]map[string][]string{
+		rel: {"ARTIST": {"Example A"}},
+	}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := taglib.ReadTags(abs)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
+
+func TestReadWriteTagsFromStream(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.CreateTemp(t.TempDir(), "eg-*.flac")
+	nilErr(t, err)
+	defer f.Close()
+
+	_, err = f.Write(egFLAC)
+	nilErr(t, err)
+
+	err = taglib.WriteTagsToReadWriteSeeker(f, int64(len(egFLAC)), map[string][]string{
+		"ARTIST": {"Example A"},
+	}, taglib.FormatFLAC, taglib.Clear)
+	nilErr(t, err)
+
+	info, err := f.Stat()
+	nilErr(t, err)
+
+	got, err := taglib.ReadTagsFromReader(f, info.Size(), taglib.FormatFLAC)
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
+
+func TestSession(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	nilErr(t, os.WriteFile(filepath.Join(dir, "eg.flac"), egFLAC, os.ModePerm))
+
+	sess, err := taglib.NewSession(dir, false)
+	nilErr(t, err)
+	defer sess.Close()
+
+	err = sess.WriteTags("eg.flac", map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	tags, err := sess.ReadTags("eg.flac")
+	nilErr(t, err)
+	tagEq(t, tags, map[string][]string{"ARTIST": {"Example A"}})
+
+	props, err := sess.ReadProperties("eg.flac")
+	nilErr(t, err)
+	eq(t, 1*time.Second, props.Length)
+}
+
+func TestWalkDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	nilErr(t, os.WriteFile(filepath.Join(dir, "eg.flac"), egFLAC, os.ModePerm))
+	nilErr(t, os.WriteFile(filepath.Join(dir, "eg.mp3"), egMP3, os.ModePerm))
+
+	var seen []string
+	err := taglib.WalkDir(dir, func(path string, tags map[string][]string, props taglib.Properties) error {
+		seen = append(seen, filepath.Base(path))
+		return nil
+	})
+	nilErr(t, err)
+	slices.Sort(seen)
+	eq(t, strings.Join(seen, ","), "eg.flac,eg.mp3")
+}
+
 func TestAudioProperties(t *testing.T) {
 	t.Parallel()
 
@@ -327,6 +462,116 @@ func TestWriteImage(t *testing.T) {
 	nilErr(t, os.Remove(coverpath))
 }
 
+func TestReadWriteImages(t *testing.T) {
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	err := taglib.WritePictures(path, []taglib.Picture{
+		{Type: taglib.PictureTypeFrontCover, MIME: "image/jpeg", Description: "front", Data: coverJPG},
+		{Type: taglib.PictureTypeBackCover, MIME: "image/jpeg", Description: "back", Data: coverJPG},
+	}, taglib.Clear)
+	nilErr(t, err)
+
+	pics, err := taglib.ReadImages(path)
+	nilErr(t, err)
+	eq(t, len(pics), 2)
+
+	var sawFront, sawBack bool
+	for _, p := range pics {
+		switch p.Type {
+		case taglib.PictureTypeFrontCover:
+			sawFront = true
+			eq(t, p.Description, "front")
+		case taglib.PictureTypeBackCover:
+			sawBack = true
+			eq(t, p.Description, "back")
+		}
+	}
+	if !sawFront || !sawBack {
+		t.Fatalf("expected front and back covers, got %+v", pics)
+	}
+}
+
+func TestReadWriteChapters(t *testing.T) {
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	want := []taglib.Chapter{
+		{Title: "Intro", Start: 0, End: 30 * time.Second},
+		{Title: "Verse", Start: 30 * time.Second, End: 90 * time.Second},
+	}
+	nilErr(t, taglib.WriteChapters(path, want))
+
+	got, err := taglib.ReadChapters(path)
+	nilErr(t, err)
+	eq(t, len(got), len(want))
+	for i := range got {
+		eq(t, got[i].Title, want[i].Title)
+		eq(t, got[i].Start, want[i].Start)
+		eq(t, got[i].End, want[i].End)
+	}
+}
+
+func TestReadWriteLyrics(t *testing.T) {
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	want := []taglib.EmbeddedLyrics{
+		{
+			Language: "eng",
+			Unsynced: "hello world",
+			Synced: []taglib.LyricLine{
+				{Time: 0, Text: "hello"},
+				{Time: 1 * time.Second, Text: "world"},
+			},
+		},
+	}
+	nilErr(t, taglib.WriteLyrics(path, want, taglib.Clear))
+
+	got, err := taglib.ReadLyrics(path)
+	nilErr(t, err)
+	eq(t, len(got), len(want))
+	eq(t, got[0].Language, want[0].Language)
+	eq(t, got[0].Unsynced, want[0].Unsynced)
+	eq(t, len(got[0].Synced), len(want[0].Synced))
+	for i := range got[0].Synced {
+		eq(t, got[0].Synced[i].Time, want[0].Synced[i].Time)
+		eq(t, got[0].Synced[i].Text, want[0].Synced[i].Text)
+	}
+}
+
+func TestParseFormatLRC(t *testing.T) {
+	t.Parallel()
+
+	lrc := "[00:00.00]hello\n[00:01.50]world\n"
+	lyrics, err := taglib.ParseLRC(strings.NewReader(lrc))
+	nilErr(t, err)
+
+	eq(t, len(lyrics.Synced), 2)
+	eq(t, lyrics.Synced[0].Text, "hello")
+	eq(t, lyrics.Synced[1].Time, 1500*time.Millisecond)
+	eq(t, lyrics.Unsynced, "hello\nworld")
+
+	eq(t, taglib.FormatLRC(lyrics), lrc)
+}
+
+func TestRemovePictures(t *testing.T) {
+	path := tmpf(t, egFLAC, "eg.flac")
+
+	err := taglib.WritePictures(path, []taglib.Picture{
+		{Type: taglib.PictureTypeFrontCover, MIME: "image/jpeg", Description: "front", Data: coverJPG},
+		{Type: taglib.PictureTypeBackCover, MIME: "image/jpeg", Description: "back", Data: coverJPG},
+	}, taglib.Clear)
+	nilErr(t, err)
+
+	err = taglib.RemovePictures(path, func(p taglib.Picture) bool {
+		return p.Type == taglib.PictureTypeBackCover
+	})
+	nilErr(t, err)
+
+	pics, err := taglib.ReadPictures(path)
+	nilErr(t, err)
+	eq(t, len(pics), 1)
+	eq(t, pics[0].Type, taglib.PictureTypeFrontCover)
+}
+
 func TestClearImage(t *testing.T) {
 	path := tmpf(t, egFLAC, "eg.flac")
 
@@ -381,6 +626,53 @@ func TestMemSameFile(t *testing.T) {
 	t.Logf("alloc = %v MiB", memStats.Alloc/1024/1024)
 }
 
+func TestReadTagsFromBuffer(t *testing.T) {
+	t.Parallel()
+
+	path := tmpf(t, egFLAC, "eg.flac")
+	err := taglib.WriteTags(path, map[string][]string{"ARTIST": {"Example A"}}, taglib.Clear)
+	nilErr(t, err)
+
+	data, err := os.ReadFile(path)
+	nilErr(t, err)
+
+	got, err := taglib.ReadTagsFromBuffer(bytes.NewReader(data))
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
+
+func TestReadPropertiesFromBuffer(t *testing.T) {
+	t.Parallel()
+
+	props, err := taglib.ReadPropertiesFromBuffer(bytes.NewReader(egFLAC))
+	nilErr(t, err)
+	if props.Length == 0 {
+		t.Fatalf("expected non-zero length, got %v", props.Length)
+	}
+}
+
+func TestWriteTagsToBuffer(t *testing.T) {
+	t.Parallel()
+
+	out, err := taglib.WriteTagsToBuffer(bytes.NewReader(egFLAC), map[string][]string{
+		"ARTIST": {"Example A"},
+	}, taglib.Clear)
+	nilErr(t, err)
+
+	got, err := taglib.ReadTagsFromBuffer(bytes.NewReader(out))
+	nilErr(t, err)
+	tagEq(t, got, map[string][]string{"ARTIST": {"Example A"}})
+}
+
+func TestReadTagsFromBufferUnrecognized(t *testing.T) {
+	t.Parallel()
+
+	_, err := taglib.ReadTagsFromBuffer(bytes.NewReader([]byte("not audio")))
+	if err == nil {
+		t.Fatal("expected error for unrecognized format")
+	}
+}
+
 func BenchmarkWrite(b *testing.B) {
 	path := tmpf(b, egFLAC, "eg.flac")
 	b.ResetTimer()